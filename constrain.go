@@ -1,13 +1,13 @@
 package ekit
 
+import "github.com/lhh-gh/ekit/constraints"
+
 // RealNumber 实数
 // 绝大多数情况下，你都应该用这个来表达数字的含义
-type RealNumber interface {
-	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
-		~int | ~int8 | ~int16 | ~int32 | ~int64 |
-		~float32 | ~float64
-}
-
-type Number interface {
-	RealNumber | ~complex64 | ~complex128
-}
+type RealNumber = constraints.RealNumber
+
+// Number 数字，包含了复数
+type Number = constraints.Number
+
+// Ordered 可以用 < > 比较大小的类型
+type Ordered = constraints.Ordered
@@ -0,0 +1,45 @@
+// Package constraints 定义了 ekit 中通用的泛型约束
+// ekit 中的 RealNumber、Number、Ordered 都是这里对应约束的别名，
+// 所有的切片、聚合函数都共享这一份定义
+package constraints
+
+// Signed 有符号整数
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned 无符号整数
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Integer 整数
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float 浮点数
+type Float interface {
+	~float32 | ~float64
+}
+
+// Complex 复数
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// RealNumber 实数
+// 绝大多数情况下，你都应该用这个来表达数字的含义
+type RealNumber interface {
+	Integer | Float
+}
+
+// Number 数字，包含了复数
+type Number interface {
+	RealNumber | Complex
+}
+
+// Ordered 可以用 < > 比较大小的类型
+type Ordered interface {
+	RealNumber | ~string
+}
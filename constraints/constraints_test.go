@@ -0,0 +1,76 @@
+package constraints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteger(t *testing.T) {
+	assert.Equal(t, 6, sumInteger([]int{1, 2, 3}))
+	assert.Equal(t, int8(6), sumInteger([]int8{1, 2, 3}))
+	assert.Equal(t, int64(6), sumInteger([]int64{1, 2, 3}))
+	assert.Equal(t, uint(6), sumInteger([]uint{1, 2, 3}))
+	assert.Equal(t, uint32(6), sumInteger([]uint32{1, 2, 3}))
+	assert.Equal(t, myInt(6), sumInteger([]myInt{1, 2, 3}))
+}
+
+func TestFloat(t *testing.T) {
+	assert.Equal(t, float32(1.5), half[float32](3))
+	assert.Equal(t, 1.5, half[float64](3))
+}
+
+func TestNumber(t *testing.T) {
+	assert.Equal(t, 6, sumNumber([]int{1, 2, 3}))
+	assert.Equal(t, 6.5, sumNumber([]float64{1, 2, 3.5}))
+	assert.Equal(t, complex(3, 3), sumNumber([]complex128{complex(1, 1), complex(2, 2)}))
+	assert.Equal(t, complex64(complex(3, 3)), sumNumber([]complex64{complex(1, 1), complex(2, 2)}))
+}
+
+func TestRealNumber(t *testing.T) {
+	assert.Equal(t, 2.0, toFloat(2))
+	assert.Equal(t, 2.0, toFloat(uint8(2)))
+	assert.Equal(t, 2.5, toFloat(float32(2.5)))
+}
+
+func TestOrdered(t *testing.T) {
+	assert.Equal(t, 3, maxOf(1, 3))
+	assert.Equal(t, 3.5, maxOf(3.5, 1))
+	assert.Equal(t, "b", maxOf("a", "b"))
+	assert.Equal(t, myString("b"), maxOf[myString]("b", "a"))
+}
+
+type myInt int
+
+type myString string
+
+func sumInteger[T Integer](src []T) T {
+	var res T
+	for _, v := range src {
+		res += v
+	}
+	return res
+}
+
+func half[T Float](v T) T {
+	return v / 2
+}
+
+func sumNumber[T Number](src []T) T {
+	var res T
+	for _, v := range src {
+		res += v
+	}
+	return res
+}
+
+func toFloat[T RealNumber](v T) float64 {
+	return float64(v)
+}
+
+func maxOf[T Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
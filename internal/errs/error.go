@@ -1,15 +1,24 @@
 package errs
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
 
+// ErrEmptySlice 代表切片为空，无法完成计算
+var ErrEmptySlice = errors.New("ekit: 切片为空")
+
 // NewErrIndexOutOfRange 创建一个代表下标超出范围的错误
 func NewErrIndexOutOfRange(length int, index int) error {
 	return fmt.Errorf("ekit: 下标超出范围，长度 %d, 下标 %d", length, index)
 }
 
+// NewErrInvalidRange 创建一个代表区间 [start, end) 不合法的错误
+func NewErrInvalidRange(length int, start int, end int) error {
+	return fmt.Errorf("ekit: 无效的区间 [%d, %d), 长度 %d", start, end, length)
+}
+
 // NewErrInvalidType 创建一个代表类型转换失败的错误
 func NewErrInvalidType(want string, got any) error {
 	return fmt.Errorf("ekit: 类型转换失败，预期类型:%s, 实际值:%#v", want, got)
@@ -26,3 +35,23 @@ func NewErrInvalidMaxIntervalValue(maxInterval, initialInterval time.Duration) e
 func NewErrRetryExhausted(lastErr error) error {
 	return fmt.Errorf("ekit: 超过最大重试次数，业务返回的最后一个 error %w", lastErr)
 }
+
+// NewErrInvalidPercentile 创建一个代表百分位数超出 [0, 100] 范围的错误
+func NewErrInvalidPercentile(p float64) error {
+	return fmt.Errorf("ekit: 无效的百分位数 %v, 预期值应在 [0, 100] 之间", p)
+}
+
+// NewErrInvalidSize 创建一个代表分批、分块大小不合法的错误
+func NewErrInvalidSize(size int) error {
+	return fmt.Errorf("ekit: 无效的大小 %d, 预期值应大于 0", size)
+}
+
+// NewErrInvalidWindowSize 创建一个代表滑动窗口大小不合法的错误
+func NewErrInvalidWindowSize(size int, length int) error {
+	return fmt.Errorf("ekit: 无效的窗口大小 %d, 预期值应在 [1, %d] 之间", size, length)
+}
+
+// NewErrLengthMismatch 创建一个代表两个切片长度不一致的错误
+func NewErrLengthMismatch(a int, b int) error {
+	return fmt.Errorf("ekit: 切片长度不一致，%d != %d", a, b)
+}
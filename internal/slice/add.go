@@ -1,6 +1,10 @@
 package slice
 
-import "github.com/lhh-gh/ekit/internal/errs"
+import (
+	"unsafe"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+)
 
 // Add 在切片 src 的指定位置 index 处插入元素 element，并返回新切片
 // 参数：
@@ -48,3 +52,47 @@ func Add[T any](src []T, element T, index int) ([]T, error) {
 	// 返回新切片（底层数组可能已变更）
 	return src, nil
 }
+
+// AddMany 在切片 src 的 index 处一次性插入多个元素，保持 elements 原有的顺序
+// index 范围应为 [0, len(src)]，只校验一次
+// 和循环调用 Add 相比，底层数组最多只会扩容一次，尾部元素也只需要整体移动一次
+// elements 为空时直接返回 src，和 Add 一样，返回的切片和 src 共享底层数组
+//
+// 实现步骤：
+// 1. 如果 elements 和 src[index:cap(src)] 有重叠，先复制一份 elements，
+// 因为后面两步可能会覆盖这部分内存
+// 2. 通过 append 扩展长度，这一步完成唯一的一次扩容
+// 3. 通过一次 copy 将 [index, len(src)) 整体后移 len(elements) 位
+// 4. 通过一次 copy 将 elements 放到 index 处
+func AddMany[T any](src []T, index int, elements ...T) ([]T, error) {
+	length := len(src)
+	if index < 0 || index > length {
+		return nil, errs.NewErrIndexOutOfRange(length, index)
+	}
+	if len(elements) == 0 {
+		return src, nil
+	}
+	if overlaps(elements, src[index:cap(src)]) {
+		elements = append([]T(nil), elements...)
+	}
+	res := append(src, elements...)
+	copy(res[index+len(elements):], res[index:length])
+	copy(res[index:], elements)
+	return res, nil
+}
+
+// overlaps 判断 a 和 b 是否使用了同一段内存
+func overlaps[T any](a, b []T) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	size := unsafe.Sizeof(a[0])
+	if size == 0 {
+		return false
+	}
+	aStart := uintptr(unsafe.Pointer(unsafe.SliceData(a)))
+	bStart := uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+	aEnd := aStart + uintptr(len(a))*size
+	bEnd := bStart + uintptr(len(b))*size
+	return aStart < bEnd && bStart < aEnd
+}
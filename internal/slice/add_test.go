@@ -95,3 +95,139 @@ func TestAdd(t *testing.T) {
 		})
 	}
 }
+
+func TestAddMany(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		index     int
+		elements  []int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "index 0",
+			slice:     []int{1, 2},
+			index:     0,
+			elements:  []int{7, 8, 9},
+			wantSlice: []int{7, 8, 9, 1, 2},
+		},
+		{
+			name:      "index middle",
+			slice:     []int{1, 2, 3, 4},
+			index:     2,
+			elements:  []int{7, 8},
+			wantSlice: []int{1, 2, 7, 8, 3, 4},
+		},
+		{
+			name:      "index last",
+			slice:     []int{1, 2},
+			index:     2,
+			elements:  []int{7, 8},
+			wantSlice: []int{1, 2, 7, 8},
+		},
+		{
+			name:      "nil slice",
+			index:     0,
+			elements:  []int{7, 8},
+			wantSlice: []int{7, 8},
+		},
+		{
+			name:      "no elements",
+			slice:     []int{1, 2},
+			index:     1,
+			wantSlice: []int{1, 2},
+		},
+		{
+			name:     "index out of range",
+			slice:    []int{1, 2},
+			index:    3,
+			elements: []int{7},
+			wantErr:  errs.NewErrIndexOutOfRange(2, 3),
+		},
+		{
+			name:     "index less than 0",
+			slice:    []int{1, 2},
+			index:    -1,
+			elements: []int{7},
+			wantErr:  errs.NewErrIndexOutOfRange(2, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := AddMany(tc.slice, tc.index, tc.elements...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+// TestAddMany_Aliasing elements 和 src 共享同一个底层数组
+func TestAddMany_Aliasing(t *testing.T) {
+	testCases := []struct {
+		name      string
+		index     int
+		elements  func(src []int) []int
+		wantSlice []int
+	}{
+		{
+			name:  "elements after index",
+			index: 1,
+			elements: func(src []int) []int {
+				return src[2:4]
+			},
+			wantSlice: []int{1, 3, 4, 2, 3, 4, 5},
+		},
+		{
+			name:  "elements before index",
+			index: 4,
+			elements: func(src []int) []int {
+				return src[0:2]
+			},
+			wantSlice: []int{1, 2, 3, 4, 1, 2, 5},
+		},
+		{
+			name:  "whole slice",
+			index: 0,
+			elements: func(src []int) []int {
+				return src
+			},
+			wantSlice: []int{1, 2, 3, 4, 5, 1, 2, 3, 4, 5},
+		},
+		{
+			name:  "elements in spare capacity",
+			index: 1,
+			elements: func(src []int) []int {
+				spare := src[5:7]
+				spare[0], spare[1] = 6, 7
+				return spare
+			},
+			wantSlice: []int{1, 6, 7, 2, 3, 4, 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// 预留足够的容量，保证不会扩容，从而真正共享底层数组
+			src := make([]int, 5, 16)
+			copy(src, []int{1, 2, 3, 4, 5})
+			res, err := AddMany(src, tc.index, tc.elements(src)...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+// TestAddMany_NoElements 没有 elements 时直接返回 src
+func TestAddMany_NoElements(t *testing.T) {
+	src := []int{1, 2, 3}
+	res, err := AddMany(src, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+	// 和 src 共享底层数组
+	assert.Same(t, &src[0], &res[0])
+}
@@ -35,6 +35,45 @@ func Delete[T any](src []T, index int) ([]T, T, error) {
 	return src, res, nil
 }
 
+// DeleteSafe 和 Delete 一样删除 index 位置的元素，
+// 区别在于元素前移之后，会把空出来的最后一个位置置为零值再截断
+//
+// Delete 截断之后，底层数组的最后一个位置依旧引用着被挪走的元素，
+// 当元素是指针、接口、切片、map 之类的类型时，该位置引用的对象无法被 GC 回收。
+// 如果元素类型不包含引用，或者切片很快就会被丢弃，使用 Delete 即可；
+// 否则应该优先使用 DeleteSafe
+func DeleteSafe[T any](src []T, index int) ([]T, T, error) {
+	length := len(src)
+	res, val, err := Delete[T](src, index)
+	if err != nil {
+		return res, val, err
+	}
+	// 此时 src[length-1] 已经不在 res 的范围内，但是依旧位于底层数组中
+	var zero T
+	src[length-1] = zero
+	return res, val, nil
+}
+
+// DeleteRange 删除 [start, end) 区间内的元素，并返回新切片
+// 需要满足 0 <= start <= end <= len(src)，否则返回错误
+// start == end 的时候不会删除任何元素，直接返回 src
+// 和 DeleteSafe 一样，被截断的尾部位置会被置为零值，避免指针类型的元素无法被回收
+func DeleteRange[T any](src []T, start, end int) ([]T, error) {
+	length := len(src)
+	if start < 0 || start > end || end > length {
+		return nil, errs.NewErrInvalidRange(length, start, end)
+	}
+	if start == end {
+		return src, nil
+	}
+	n := copy(src[start:], src[end:])
+	var zero T
+	for i := start + n; i < length; i++ {
+		src[i] = zero
+	}
+	return src[:start+n], nil
+}
+
 ///需要动态维护有序数据集合
 //实现队列/栈等数据结构时的元素移除操作
 //处理用户列表、日志记录等需要动态删除的场景
@@ -63,3 +63,167 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteSafe(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		index     int
+		wantSlice []int
+		wantVal   int
+		wantErr   error
+	}{
+		{
+			name:      "index 0",
+			slice:     []int{123, 100},
+			index:     0,
+			wantSlice: []int{100},
+			wantVal:   123,
+		},
+		{
+			name:      "index middle",
+			slice:     []int{123, 124, 125},
+			index:     1,
+			wantSlice: []int{123, 125},
+			wantVal:   124,
+		},
+		{
+			name:    "index out of range",
+			slice:   []int{123, 100},
+			index:   12,
+			wantErr: errs.NewErrIndexOutOfRange(2, 12),
+		},
+		{
+			name:    "index less than 0",
+			slice:   []int{123, 100},
+			index:   -1,
+			wantErr: errs.NewErrIndexOutOfRange(2, -1),
+		},
+		{
+			name:      "index last",
+			slice:     []int{123, 100, 101},
+			index:     2,
+			wantSlice: []int{123, 100},
+			wantVal:   101,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			length := len(tc.slice)
+			res, val, err := DeleteSafe(tc.slice, tc.index)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+			assert.Equal(t, tc.wantVal, val)
+			assert.Equal(t, 0, tc.slice[length-1])
+		})
+	}
+}
+
+func TestDeleteSafe_Pointer(t *testing.T) {
+	a, b, c := 1, 2, 3
+	src := []*int{&a, &b, &c}
+	res, val, err := DeleteSafe(src, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, &a, val)
+	assert.Equal(t, []*int{&b, &c}, res)
+	// 空出来的位置不再引用任何对象
+	assert.Nil(t, res[:cap(res)][2])
+}
+
+func TestDeleteRange(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		start     int
+		end       int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "head",
+			slice:     []int{1, 2, 3, 4, 5},
+			start:     0,
+			end:       2,
+			wantSlice: []int{3, 4, 5},
+		},
+		{
+			name:      "middle",
+			slice:     []int{1, 2, 3, 4, 5},
+			start:     1,
+			end:       4,
+			wantSlice: []int{1, 5},
+		},
+		{
+			name:      "tail",
+			slice:     []int{1, 2, 3, 4, 5},
+			start:     3,
+			end:       5,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "all",
+			slice:     []int{1, 2, 3},
+			start:     0,
+			end:       3,
+			wantSlice: []int{},
+		},
+		{
+			name:      "empty range",
+			slice:     []int{1, 2, 3},
+			start:     1,
+			end:       1,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:    "start less than 0",
+			slice:   []int{1, 2, 3},
+			start:   -1,
+			end:     1,
+			wantErr: errs.NewErrInvalidRange(3, -1, 1),
+		},
+		{
+			name:    "start greater than end",
+			slice:   []int{1, 2, 3},
+			start:   2,
+			end:     1,
+			wantErr: errs.NewErrInvalidRange(3, 2, 1),
+		},
+		{
+			name:    "end out of range",
+			slice:   []int{1, 2, 3},
+			start:   1,
+			end:     4,
+			wantErr: errs.NewErrInvalidRange(3, 1, 4),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			length := len(tc.slice)
+			res, err := DeleteRange(tc.slice, tc.start, tc.end)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+			// 被截断的尾部都应该是零值
+			for _, v := range tc.slice[len(res):length] {
+				assert.Equal(t, 0, v)
+			}
+		})
+	}
+}
+
+func TestDeleteRange_Pointer(t *testing.T) {
+	a, b, c, d := 1, 2, 3, 4
+	src := []*int{&a, &b, &c, &d}
+	res, err := DeleteRange(src, 1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []*int{&a, &d}, res)
+	assert.Nil(t, src[2])
+	assert.Nil(t, src[3])
+}
@@ -1,5 +1,21 @@
 package slice
 
+// 缩容策略使用的阈值和系数
+const (
+	// shrinkMinCap 容量不超过该值的切片不缩容
+	shrinkMinCap = 64
+	// shrinkLargeCap 容量超过该值的切片被视为大切片
+	shrinkLargeCap = 2048
+	// shrinkLargeRatio 大切片的容量达到长度的该倍数时缩容
+	shrinkLargeRatio = 2
+	// shrinkLargeFactor 大切片缩容后的容量为原容量乘以该系数
+	shrinkLargeFactor = 0.625
+	// shrinkSmallRatio 常规切片的容量达到长度的该倍数时缩容
+	shrinkSmallRatio = 4
+	// shrinkSmallDivisor 常规切片缩容后的容量为原容量除以该值
+	shrinkSmallDivisor = 2
+)
+
 // calCapacity 计算切片缩容后的目标容量（内存优化核心算法）
 // 参数说明：
 //   - c: 当前切片容量(capacity)
@@ -10,20 +26,20 @@ package slice
 //   - bool: 是否执行缩容操作
 //
 // 缩容策略说明：
-//  1. 小容量（<=64）保持不动：避免频繁内存分配影响性能
-//  2. 超大容量（>2048）且利用率不足50%：降低到62.5%（避免大规模内存浪费）
-//  3. 常规容量（64-2048）且利用率不足25%：压缩到50%（平衡内存和性能）
+//  1. 小容量（<=shrinkMinCap）保持不动：避免频繁内存分配影响性能
+//  2. 超大容量（>shrinkLargeCap）且利用率不足50%：降低到62.5%（避免大规模内存浪费）
+//  3. 常规容量（shrinkMinCap-shrinkLargeCap）且利用率不足25%：压缩到50%（平衡内存和性能）
 //  4. 其他情况保持原容量
 func calCapacity(c, l int) (int, bool) {
-	if c <= 64 {
+	if c <= shrinkMinCap {
 		return c, false // 小容量不缩容
 	}
-	if c > 2048 && (c/l >= 2) {
-		factor := 0.625
-		return int(float32(c) * float32(factor)), true // 渐进式缩容
+	// 长度为 0 的时候冗余无限大，一定满足缩容条件
+	if c > shrinkLargeCap && (l == 0 || c/l >= shrinkLargeRatio) {
+		return int(float32(c) * float32(shrinkLargeFactor)), true // 渐进式缩容
 	}
-	if c <= 2048 && (c/l >= 4) {
-		return c / 2, true // 激进式缩容
+	if c <= shrinkLargeCap && (l == 0 || c/l >= shrinkSmallRatio) {
+		return c / shrinkSmallDivisor, true // 激进式缩容
 	}
 	return c, false
 }
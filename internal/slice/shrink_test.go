@@ -36,6 +36,18 @@ func TestShrink(t *testing.T) {
 			enqueueLoop: 60,
 			expectCap:   1875,
 		},
+		{
+			name:        "小于2048, 长度为0",
+			originCap:   1000,
+			enqueueLoop: 0,
+			expectCap:   500,
+		},
+		{
+			name:        "大于2048, 长度为0",
+			originCap:   3000,
+			enqueueLoop: 0,
+			expectCap:   1875,
+		},
 		{
 			name:        "大于2048，大于一半",
 			originCap:   3000,
@@ -0,0 +1,13 @@
+package ekit
+
+// Pair 键值对，用于在切片中保存成对出现的数据
+// 例如统计结果中的 (值, 次数)
+type Pair[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// NewPair 创建一个 Pair
+func NewPair[K any, V any](key K, value V) Pair[K, V] {
+	return Pair[K, V]{Key: key, Value: value}
+}
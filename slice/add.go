@@ -9,3 +9,10 @@ func Add[Src any](src []Src, element Src, index int) ([]Src, error) {
 	res, err := slice.Add[Src](src, element, index)
 	return res, err
 }
+
+// AddMany 在 index 处一次性插入多个元素，保持 elements 原有的顺序
+// index 范围应为[0, len(src)]
+// elements 为空的时候直接返回 src，返回的切片和 src 共享底层数组
+func AddMany[Src any](src []Src, index int, elements ...Src) ([]Src, error) {
+	return slice.AddMany[Src](src, index, elements...)
+}
@@ -53,5 +53,43 @@ func ExampleAdd() {
 	fmt.Println(err)
 	// Output:
 	// [1 2 233 3 4]
-	// 下标超出范围，长度 4, 下标 -1
+	// ekit: 下标超出范围，长度 4, 下标 -1
+}
+
+func TestAddMany(t *testing.T) {
+	// AddMany 主要依赖于 internal/slice.AddMany 来保证正确性
+	testCases := []struct {
+		name      string
+		slice     []int
+		index     int
+		elements  []int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "index middle",
+			slice:     []int{1, 2, 3},
+			index:     1,
+			elements:  []int{7, 8},
+			wantSlice: []int{1, 7, 8, 2, 3},
+		},
+		{
+			name:     "index -1",
+			slice:    []int{1, 2},
+			index:    -1,
+			elements: []int{7},
+			wantErr:  errs.NewErrIndexOutOfRange(2, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := AddMany(tc.slice, tc.index, tc.elements...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
 }
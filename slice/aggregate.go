@@ -0,0 +1,90 @@
+package slice
+
+import (
+	"github.com/lhh-gh/ekit"
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// Max 返回 src 中的最大值
+// src 为空的时候返回 errs.ErrEmptySlice，而不是一个可能被误认为是真实数据的零值
+// 对于浮点数，NaN 的比较结果总是 false，因此 NaN 不会被选为最大值（除非它是第一个元素）
+func Max[T ekit.Ordered](src []T) (T, error) {
+	return MaxFunc(src, compare[T])
+}
+
+// Min 返回 src 中的最小值
+// src 为空的时候返回 errs.ErrEmptySlice
+func Min[T ekit.Ordered](src []T) (T, error) {
+	return MinFunc(src, compare[T])
+}
+
+// MaxFunc 按照 cmp 返回 src 中最大的元素，有多个最大值的时候返回第一个
+// cmp(a, b) 在 a < b 时返回负数，a == b 时返回 0，a > b 时返回正数
+// 例如查找最晚的 time.Time，或者按照某个字段查找最大的结构体
+// src 为空的时候返回 errs.ErrEmptySlice
+func MaxFunc[T any](src []T, cmp func(a, b T) int) (T, error) {
+	return extremeFunc(src, func(cur, best T) bool {
+		return cmp(cur, best) > 0
+	})
+}
+
+// MinFunc 按照 cmp 返回 src 中最小的元素，有多个最小值的时候返回第一个
+// src 为空的时候返回 errs.ErrEmptySlice
+func MinFunc[T any](src []T, cmp func(a, b T) int) (T, error) {
+	return extremeFunc(src, func(cur, best T) bool {
+		return cmp(cur, best) < 0
+	})
+}
+
+// extremeFunc better 返回 true 的时候，cur 会替换当前的最优值
+func extremeFunc[T any](src []T, better func(cur, best T) bool) (T, error) {
+	if len(src) == 0 {
+		var zero T
+		return zero, errs.ErrEmptySlice
+	}
+	best := src[0]
+	for _, v := range src[1:] {
+		if better(v, best) {
+			best = v
+		}
+	}
+	return best, nil
+}
+
+// compare 比较两个可以排序的值
+func compare[T ekit.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Sum 求和
+// 整数类型溢出的时候和 Go 的整数运算一样按照补码回绕，不会返回错误，
+// 如果需要避免溢出，可以先用 Map 转化为更宽的类型再求和
+// src 为空的时候返回 0
+func Sum[T ekit.Number](src []T) T {
+	var res T
+	for _, v := range src {
+		res += v
+	}
+	return res
+}
+
+// Avg 求平均值
+// 每一个元素会先转化为 float64 再累加，因此整数不会溢出，但是非常大的整数可能损失精度
+// src 为空的时候返回 errs.ErrEmptySlice，而不是一个可能被误认为是真实数据的 0
+func Avg[T ekit.RealNumber](src []T) (float64, error) {
+	if len(src) == 0 {
+		return 0, errs.ErrEmptySlice
+	}
+	var sum float64
+	for _, v := range src {
+		sum += float64(v)
+	}
+	return sum / float64(len(src)), nil
+}
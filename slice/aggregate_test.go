@@ -3,8 +3,10 @@ package slice
 import (
 	"fmt"
 	"github.com/lhh-gh/ekit"
+	"github.com/lhh-gh/ekit/internal/errs"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestMax(t *testing.T) {
@@ -27,17 +29,20 @@ func TestMax(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			res := Max[Integer](tc.input)
+			res, err := Max[Integer](tc.input)
+			assert.NoError(t, err)
 			assert.Equal(t, tc.want, res)
 		})
 	}
 
-	assert.Panics(t, func() {
-		Max[int](nil)
-	})
-	assert.Panics(t, func() {
-		Max[int]([]int{})
-	})
+	_, err := Max[int](nil)
+	assert.Equal(t, errs.ErrEmptySlice, err)
+	_, err = Max[int]([]int{})
+	assert.Equal(t, errs.ErrEmptySlice, err)
+
+	res, err := Max[string]([]string{"b", "c", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, "c", res)
 
 	testMaxTypes[uint](t)
 	testMaxTypes[uint8](t)
@@ -73,17 +78,20 @@ func TestMin(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			res := Min[Integer](tc.input)
+			res, err := Min[Integer](tc.input)
+			assert.NoError(t, err)
 			assert.Equal(t, tc.want, res)
 		})
 	}
 
-	assert.Panics(t, func() {
-		Min[int](nil)
-	})
-	assert.Panics(t, func() {
-		Min[int]([]int{})
-	})
+	_, err := Min[int](nil)
+	assert.Equal(t, errs.ErrEmptySlice, err)
+	_, err = Min[int]([]int{})
+	assert.Equal(t, errs.ErrEmptySlice, err)
+
+	res, err := Min[string]([]string{"b", "c", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", res)
 
 	testMinTypes[uint](t)
 	testMinTypes[uint8](t)
@@ -99,6 +107,62 @@ func TestMin(t *testing.T) {
 	testMinTypes[float64](t)
 }
 
+func TestMaxFunc(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name    string
+		input   []time.Time
+		wantMax time.Time
+		wantMin time.Time
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: errs.ErrEmptySlice,
+		},
+		{
+			name:    "value",
+			input:   []time.Time{base},
+			wantMax: base,
+			wantMin: base,
+		},
+		{
+			name:    "values",
+			input:   []time.Time{base.Add(time.Hour), base.Add(3 * time.Hour), base},
+			wantMax: base.Add(3 * time.Hour),
+			wantMin: base,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxRes, err := MaxFunc(tc.input, time.Time.Compare)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMax, maxRes)
+			minRes, err := MinFunc(tc.input, time.Time.Compare)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMin, minRes)
+		})
+	}
+}
+
+func TestMaxFunc_FirstWins(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	users := []user{{Name: "Tom", Age: 18}, {Name: "Jerry", Age: 20}, {Name: "Spike", Age: 20}, {Name: "Tyke", Age: 18}}
+	cmp := func(a, b user) int {
+		return a.Age - b.Age
+	}
+	res, err := MaxFunc(users, cmp)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jerry", res.Name)
+	res, err = MinFunc(users, cmp)
+	assert.NoError(t, err)
+	assert.Equal(t, "Tom", res.Name)
+}
+
 func TestSum(t *testing.T) {
 	testCases := []struct {
 		name  string
@@ -144,15 +208,81 @@ func TestSum(t *testing.T) {
 	testSumTypes[float64](t)
 }
 
+func TestSum_Complex(t *testing.T) {
+	res := Sum[complex128]([]complex128{complex(1, 2), complex(3, 4)})
+	assert.Equal(t, complex(4, 6), res)
+}
+
+func TestAvg(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []int
+		want    float64
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: errs.ErrEmptySlice,
+		},
+		{
+			name:    "empty",
+			input:   []int{},
+			wantErr: errs.ErrEmptySlice,
+		},
+		{
+			name:  "value",
+			input: []int{3},
+			want:  3,
+		},
+		{
+			name:  "values",
+			input: []int{1, 2, 3, 4},
+			want:  2.5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Avg[int](tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	res, err := Avg[float64]([]float64{0.5, 1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, res)
+}
+
+func BenchmarkSum(b *testing.B) {
+	src := make([]int64, 1_000_000)
+	for i := range src {
+		src[i] = int64(i)
+	}
+	b.Run("Sum", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Sum(src)
+		}
+	})
+	b.Run("Avg", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = Avg(src)
+		}
+	})
+}
+
 // testMaxTypes 只是用来测试一下满足 Max 方法约束的所有类型
 func testMaxTypes[T ekit.RealNumber](t *testing.T) {
-	res := Max[T]([]T{1, 2, 3})
+	res, err := Max[T]([]T{1, 2, 3})
+	assert.NoError(t, err)
 	assert.Equal(t, T(3), res)
 }
 
 // testMinTypes 只是用来测试一下满足 Min 方法约束的所有类型
 func testMinTypes[T ekit.RealNumber](t *testing.T) {
-	res := Min[T]([]T{1, 2, 3})
+	res, err := Min[T]([]T{1, 2, 3})
+	assert.NoError(t, err)
 	assert.Equal(t, T(1), res)
 }
 
@@ -175,15 +305,21 @@ func ExampleSum() {
 }
 
 func ExampleMin() {
-	res := Min[int]([]int{1, 2, 3})
-	fmt.Println(res)
+	res, err := Min[int]([]int{1, 2, 3})
+	fmt.Println(res, err)
+	_, err = Min[int](nil)
+	fmt.Println(err)
 	// Output:
-	// 1
+	// 1 <nil>
+	// ekit: 切片为空
 }
 
 func ExampleMax() {
-	res := Max[int]([]int{1, 2, 3})
-	fmt.Println(res)
+	res, err := Max[int]([]int{1, 2, 3})
+	fmt.Println(res, err)
+	_, err = Max[int](nil)
+	fmt.Println(err)
 	// Output:
-	// 3
+	// 3 <nil>
+	// ekit: 切片为空
 }
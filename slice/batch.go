@@ -0,0 +1,22 @@
+package slice
+
+import "github.com/lhh-gh/ekit/internal/errs"
+
+// BatchMap 将 src 按照 size 分批，每一批调用一次 m，并按照顺序拼接所有批次的结果
+// 典型场景是分批调用批量查询、批量 RPC 接口
+// 任何一批返回 error 都会立刻中断，返回该 error；size <= 0 时返回错误
+func BatchMap[Src any, Dst any](src []Src, size int, m func(batch []Src) ([]Dst, error)) ([]Dst, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize(size)
+	}
+	res := make([]Dst, 0, len(src))
+	for start := 0; start < len(src); start += size {
+		end := min(start+size, len(src))
+		dst, err := m(src[start:end:end])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, dst...)
+	}
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package slice
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchMap(t *testing.T) {
+	mockErr := errors.New("mock error")
+	toStr := func(batch []int) ([]string, error) {
+		res := make([]string, 0, len(batch))
+		for _, v := range batch {
+			res = append(res, strconv.Itoa(v))
+		}
+		return res, nil
+	}
+	testCases := []struct {
+		name        string
+		src         []int
+		size        int
+		m           func(batch []int) ([]string, error)
+		want        []string
+		wantBatches int
+		wantErr     error
+	}{
+		{
+			name:    "size 0",
+			src:     []int{1, 2},
+			size:    0,
+			m:       toStr,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name: "nil",
+			size: 2,
+			m:    toStr,
+			want: []string{},
+		},
+		{
+			name:        "multiple batches",
+			src:         []int{1, 2, 3, 4, 5},
+			size:        2,
+			m:           toStr,
+			want:        []string{"1", "2", "3", "4", "5"},
+			wantBatches: 3,
+		},
+		{
+			name: "error in middle batch",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 2,
+			m: func(batch []int) ([]string, error) {
+				if batch[0] == 3 {
+					return nil, mockErr
+				}
+				return toStr(batch)
+			},
+			wantBatches: 2,
+			wantErr:     mockErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			batches := 0
+			res, err := BatchMap(tc.src, tc.size, func(batch []int) ([]string, error) {
+				batches++
+				assert.LessOrEqual(t, len(batch), tc.size)
+				return tc.m(batch)
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantBatches, batches)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
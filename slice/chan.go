@@ -0,0 +1,24 @@
+package slice
+
+// ToChan 将切片中的元素依次写入一个带缓冲的 channel，写完后关闭 channel
+// channel 的缓冲大小为 len(src)，写入在单独的 goroutine 中完成，不会阻塞调用者
+func ToChan[T any](src []T) <-chan T {
+	ch := make(chan T, len(src))
+	go func() {
+		defer close(ch)
+		for _, v := range src {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+// FromChan 读取 channel 中的全部元素并组装成切片
+// 会一直阻塞直到 ch 被关闭
+func FromChan[T any](ch <-chan T) []T {
+	res := make([]T, 0, len(ch))
+	for v := range ch {
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToChan(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "values",
+			src:  []int{1, 2, 3},
+			want: []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := ToChan(tc.src)
+			res := FromChan(ch)
+			assert.Equal(t, tc.want, res)
+			_, ok := <-ch
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		for i := 0; i < 3; i++ {
+			ch <- i
+		}
+		close(ch)
+	}()
+	assert.Equal(t, []int{0, 1, 2}, FromChan(ch))
+}
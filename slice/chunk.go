@@ -0,0 +1,131 @@
+package slice
+
+import (
+	"iter"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// Chunk 将 src 切分为多个最多包含 size 个元素的分块，最后一个分块包含剩余的元素
+// 返回的分块是 src 的子切片，和 src 共享底层数组：
+// 只读的场景下可以避免复制，但是修改分块中的元素会影响 src，
+// 需要修改分块的时候应该使用 ChunkCopy
+// 分块的容量被限制为自身的长度，因此对分块执行 append 不会覆盖下一个分块
+// size <= 0 时返回错误，src 为空时返回 nil
+func Chunk[T any](src []T, size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize(size)
+	}
+	if len(src) == 0 {
+		return nil, nil
+	}
+	res := make([][]T, 0, (len(src)+size-1)/size)
+	for start := 0; start < len(src); start += size {
+		end := min(start+size, len(src))
+		res = append(res, src[start:end:end])
+	}
+	return res, nil
+}
+
+// ChunkCopy 和 Chunk 一样切分 src，但是返回的分块不会和 src 共享底层数组
+// 所有分块共用一次性分配的内存，修改分块不会影响 src，也不会影响其它分块
+func ChunkCopy[T any](src []T, size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize(size)
+	}
+	if len(src) == 0 {
+		return nil, nil
+	}
+	buf := make([]T, len(src))
+	copy(buf, src)
+	return Chunk(buf, size)
+}
+
+// Window 返回 src 上所有大小为 size 的连续滑动窗口，一共 len(src)-size+1 个
+// 例如 Window([]int{1, 2, 3, 4}, 2) 的结果是 [[1 2] [2 3] [3 4]]
+// 返回的窗口是 src 的子切片，和 src 共享底层数组，相邻的窗口之间也共享元素，
+// 修改窗口中的元素会影响 src 和其它窗口
+// size <= 0 或者 size > len(src) 时返回错误
+func Window[T any](src []T, size int) ([][]T, error) {
+	if size <= 0 || size > len(src) {
+		return nil, errs.NewErrInvalidWindowSize(size, len(src))
+	}
+	res := make([][]T, 0, len(src)-size+1)
+	ForEachWindow(src, size, func(window []T) bool {
+		res = append(res, window)
+		return true
+	})
+	return res, nil
+}
+
+// ForEachWindow 依次将 src 上每一个大小为 size 的滑动窗口传给 fn，fn 返回 false 时提前结束
+// 和 Window 相比，不需要一次性构造所有的窗口
+// 窗口同样和 src 共享底层数组；size <= 0 或者 size > len(src) 时不会调用 fn
+func ForEachWindow[T any](src []T, size int, fn func(window []T) bool) {
+	if size <= 0 {
+		return
+	}
+	for end := size; end <= len(src); end++ {
+		if !fn(src[end-size : end : end]) {
+			return
+		}
+	}
+}
+
+// FixedWindows 将 src 按照 size 切分成多个窗口，每个窗口都恰好有 size 个元素
+// 最后一个窗口不足 size 个元素的时候，用 pad 补齐
+// 真实元素的个数依旧是 len(src)，补齐的元素个数为 len(res)*size - len(src)
+// 返回的窗口不会和 src 共享底层数组；size <= 0 时返回 nil
+func FixedWindows[T any](src []T, size int, pad T) [][]T {
+	if size <= 0 || len(src) == 0 {
+		return nil
+	}
+	cnt := (len(src) + size - 1) / size
+	buf := make([]T, cnt*size)
+	n := copy(buf, src)
+	for i := n; i < len(buf); i++ {
+		buf[i] = pad
+	}
+	res := make([][]T, 0, cnt)
+	for i := 0; i < len(buf); i += size {
+		res = append(res, buf[i:i+size:i+size])
+	}
+	return res
+}
+
+// ChunkWhile 按照相邻元素的关系切分 src
+// 当 sameGroup(prev, cur) 返回 false 的时候，cur 会作为一个新的分块的起点，
+// 第一个元素总是第一个分块的起点
+// 返回的分块和 src 共享底层数组，修改分块中的元素会影响 src
+func ChunkWhile[T any](src []T, sameGroup func(prev, cur T) bool) [][]T {
+	if len(src) == 0 {
+		return nil
+	}
+	var res [][]T
+	start := 0
+	for i := 1; i < len(src); i++ {
+		if !sameGroup(src[i-1], src[i]) {
+			res = append(res, src[start:i:i])
+			start = i
+		}
+	}
+	return append(res, src[start:len(src):len(src)])
+}
+
+// Batched 返回一个按照 size 分批遍历 src 的迭代器，最后一批可能不足 size 个元素
+// 例如 for batch := range Batched(src, 100) {...}
+// 每一批都是 src 的子切片，不会复制元素，修改批次中的元素会影响 src
+// size <= 0 时不会产生任何批次
+func Batched[T any](src []T, size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		for start := 0; start < len(src); start += size {
+			end := min(start+size, len(src))
+			if !yield(src[start:end:end]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		size    int
+		want    [][]int
+		wantErr error
+	}{
+		{
+			name:    "size 0",
+			src:     []int{1, 2},
+			size:    0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:    "size -1",
+			src:     []int{1, 2},
+			size:    -1,
+			wantErr: errs.NewErrInvalidSize(-1),
+		},
+		{
+			name: "nil",
+			size: 2,
+		},
+		{
+			name: "exact",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "remainder",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "size larger than len",
+			src:  []int{1, 2},
+			size: 5,
+			want: [][]int{{1, 2}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Chunk(tc.src, tc.size)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+			res, err = ChunkCopy(tc.src, tc.size)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestChunk_Aliasing(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5}
+	chunks, err := Chunk(src, 2)
+	assert.NoError(t, err)
+	// 和 src 共享底层数组
+	chunks[1][0] = 30
+	assert.Equal(t, 30, src[2])
+	// append 不会覆盖下一个分块
+	_ = append(chunks[0], 100)
+	assert.Equal(t, 30, src[2])
+
+	src = []int{1, 2, 3, 4, 5}
+	chunks, err = ChunkCopy(src, 2)
+	assert.NoError(t, err)
+	chunks[1][0] = 30
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, src)
+	_ = append(chunks[0], 100)
+	assert.Equal(t, []int{30, 4}, chunks[1])
+}
+
+func TestWindow(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		size    int
+		want    [][]int
+		wantErr error
+	}{
+		{
+			name:    "size 0",
+			src:     []int{1, 2},
+			size:    0,
+			wantErr: errs.NewErrInvalidWindowSize(0, 2),
+		},
+		{
+			name:    "size larger than len",
+			src:     []int{1, 2},
+			size:    3,
+			wantErr: errs.NewErrInvalidWindowSize(3, 2),
+		},
+		{
+			name:    "nil",
+			size:    1,
+			wantErr: errs.NewErrInvalidWindowSize(1, 0),
+		},
+		{
+			name: "size equals len",
+			src:  []int{1, 2, 3},
+			size: 3,
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "normal",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name: "size 1",
+			src:  []int{1, 2, 3},
+			size: 1,
+			want: [][]int{{1}, {2}, {3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Window(tc.src, tc.size)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestForEachWindow(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5}
+	var sums []int
+	ForEachWindow(src, 3, func(window []int) bool {
+		sums = append(sums, Sum(window))
+		return true
+	})
+	assert.Equal(t, []int{6, 9, 12}, sums)
+
+	// 提前结束
+	cnt := 0
+	ForEachWindow(src, 2, func(window []int) bool {
+		cnt++
+		return window[1] < 3
+	})
+	assert.Equal(t, 2, cnt)
+
+	// 不合法的 size 不会调用 fn
+	ForEachWindow(src, 0, func(window []int) bool {
+		t.Fatal("should not be called")
+		return true
+	})
+	ForEachWindow(src, 6, func(window []int) bool {
+		t.Fatal("should not be called")
+		return true
+	})
+}
+
+func TestFixedWindows(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		size int
+		pad  int
+		want [][]int
+	}{
+		{
+			name: "nil",
+			size: 2,
+		},
+		{
+			name: "size 0",
+			src:  []int{1, 2, 3},
+			size: 0,
+		},
+		{
+			name: "exact",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			pad:  -1,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "padded",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 3,
+			pad:  -1,
+			want: [][]int{{1, 2, 3}, {4, 5, -1}},
+		},
+		{
+			name: "size larger than len",
+			src:  []int{1},
+			size: 3,
+			pad:  0,
+			want: [][]int{{1, 0, 0}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FixedWindows(tc.src, tc.size, tc.pad)
+			assert.Equal(t, tc.want, res)
+			for _, w := range res {
+				assert.Equal(t, tc.size, len(w))
+			}
+			if len(res) > 0 {
+				padded := len(res)*tc.size - len(tc.src)
+				last := res[len(res)-1]
+				for i := tc.size - padded; i < tc.size; i++ {
+					assert.Equal(t, tc.pad, last[i])
+				}
+			}
+		})
+	}
+}
+
+func TestChunkWhile(t *testing.T) {
+	smallGap := func(prev, cur int) bool { return cur-prev <= 2 }
+	testCases := []struct {
+		name string
+		src  []int
+		want [][]int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "single",
+			src:  []int{1},
+			want: [][]int{{1}},
+		},
+		{
+			name: "gap greater than 2",
+			src:  []int{1, 2, 4, 8, 9, 15},
+			want: [][]int{{1, 2, 4}, {8, 9}, {15}},
+		},
+		{
+			name: "no split",
+			src:  []int{1, 3, 5},
+			want: [][]int{{1, 3, 5}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ChunkWhile(tc.src, smallGap))
+		})
+	}
+}
+
+func TestBatched(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		size int
+		want [][]int
+	}{
+		{
+			name: "nil",
+			size: 2,
+		},
+		{
+			name: "size 0",
+			src:  []int{1, 2, 3},
+			size: 0,
+		},
+		{
+			name: "final short batch",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "exact",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var res [][]int
+			for batch := range Batched(tc.src, tc.size) {
+				res = append(res, batch)
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestBatched_Break(t *testing.T) {
+	var res [][]int
+	for batch := range Batched([]int{1, 2, 3, 4, 5}, 2) {
+		res = append(res, batch)
+		if len(res) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, res)
+}
@@ -0,0 +1,48 @@
+package slice
+
+// Compact 将相邻的重复元素压缩为一个，类似于不排序的 uniq 命令
+// 例如 [1, 1, 2, 1] 的结果是 [1, 2, 1]
+// 注意和 Unique 的区别：Compact 只去除相邻的重复元素，Unique 在整个切片范围内去重
+// 原地修改并复用 src 的底层数组，被截断的尾部位置会被置为零值，调用者应该使用返回值
+func Compact[T comparable](src []T) []T {
+	return CompactFunc(src, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// CompactFunc 和 Compact 一样，只是使用 eq 判断相邻的元素是否重复
+// eq 比较的是相邻的两个原始元素
+// 每一组相邻的重复元素只保留第一个
+func CompactFunc[T any](src []T, eq func(a, b T) bool) []T {
+	return CompactMerge(src, eq, func(a, b T) T {
+		return a
+	})
+}
+
+// CompactMerge 将相邻的、equal 判定为相等的元素通过 merge 合并成一个元素
+// 例如把相邻的同一个 key 的记录合并，并累加它们的计数
+// merge 的第一个参数是已经合并的结果，第二个参数是当前元素
+// equal 比较的是当前元素和它前一个原始元素，而不是合并之后的结果，
+// 因此 merge 修改了 equal 所依赖的字段也不会影响分组
+// 只遍历一次，并且复用 src 的底层数组，所以会修改 src 的内容
+func CompactMerge[T any](src []T, equal func(a, b T) bool, merge func(a, b T) T) []T {
+	if len(src) < 2 {
+		return src
+	}
+	w, prev := 0, src[0]
+	for i := 1; i < len(src); i++ {
+		cur := src[i]
+		if equal(prev, cur) {
+			src[w] = merge(src[w], cur)
+		} else {
+			w++
+			src[w] = cur
+		}
+		prev = cur
+	}
+	var zero T
+	for i := w + 1; i < len(src); i++ {
+		src[i] = zero
+	}
+	return src[:w+1]
+}
@@ -0,0 +1,134 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type compactRecord struct {
+	Key   string
+	Count int
+}
+
+func TestCompact(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "single",
+			src:       []int{1},
+			wantSlice: []int{1},
+		},
+		{
+			name:      "adjacent only",
+			src:       []int{1, 1, 2, 2, 2, 1, 3, 3},
+			wantSlice: []int{1, 2, 1, 3},
+		},
+		{
+			name:      "no duplicates",
+			src:       []int{1, 2, 3},
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "all same",
+			src:       []int{4, 4, 4},
+			wantSlice: []int{4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			length := len(tc.src)
+			res := Compact(tc.src)
+			assert.Equal(t, tc.wantSlice, res)
+			for _, v := range tc.src[len(res):length] {
+				assert.Equal(t, 0, v)
+			}
+		})
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	type event struct {
+		Type string
+		Seq  int
+	}
+	src := []event{{"click", 1}, {"click", 2}, {"scroll", 3}, {"click", 4}}
+	res := CompactFunc(src, func(a, b event) bool {
+		return a.Type == b.Type
+	})
+	// 每一组只保留第一个
+	assert.Equal(t, []event{{"click", 1}, {"scroll", 3}, {"click", 4}}, res)
+}
+
+func TestCompactMerge(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []compactRecord
+		want []compactRecord
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "single",
+			src:  []compactRecord{{Key: "a", Count: 1}},
+			want: []compactRecord{{Key: "a", Count: 1}},
+		},
+		{
+			name: "merge adjacent",
+			src: []compactRecord{
+				{Key: "a", Count: 1},
+				{Key: "a", Count: 2},
+				{Key: "b", Count: 3},
+				{Key: "a", Count: 4},
+				{Key: "a", Count: 5},
+				{Key: "a", Count: 6},
+			},
+			want: []compactRecord{
+				{Key: "a", Count: 3},
+				{Key: "b", Count: 3},
+				{Key: "a", Count: 15},
+			},
+		},
+		{
+			name: "no adjacent",
+			src:  []compactRecord{{Key: "a", Count: 1}, {Key: "b", Count: 2}},
+			want: []compactRecord{{Key: "a", Count: 1}, {Key: "b", Count: 2}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := CompactMerge(tc.src, func(a, b compactRecord) bool {
+				return a.Key == b.Key
+			}, func(a, b compactRecord) compactRecord {
+				a.Count += b.Count
+				return a
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+// TestCompactMerge_MergeChangesKey merge 修改了 equal 依赖的字段，分组依旧按照原始元素计算
+func TestCompactMerge_MergeChangesKey(t *testing.T) {
+	src := []compactRecord{
+		{Key: "a", Count: 1},
+		{Key: "a", Count: 2},
+		{Key: "a", Count: 3},
+		{Key: "b", Count: 4},
+	}
+	res := CompactMerge(src, func(a, b compactRecord) bool {
+		return a.Key == b.Key
+	}, func(a, b compactRecord) compactRecord {
+		return compactRecord{Key: a.Key + b.Key, Count: a.Count + b.Count}
+	})
+	assert.Equal(t, []compactRecord{{Key: "aaa", Count: 6}, {Key: "b", Count: 4}}, res)
+}
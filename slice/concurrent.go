@@ -0,0 +1,56 @@
+package slice
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// ForEachConcurrentCtx 使用最多 concurrency 个 goroutine 并发地对每一个元素调用 fn
+// ctx 会被传递给每一次 fn 调用；任何一次 fn 返回 error，或者 ctx 被取消，
+// 都会取消传给 fn 的 ctx，并且不再处理尚未开始的元素
+// 返回值是第一个 fn 返回的 error；如果是 ctx 被取消，则返回 ctx.Err()
+// 方法会等待所有已经开始执行的 fn 返回之后才返回，因此 fn 应该响应 ctx 的取消
+// concurrency <= 0 时返回错误
+func ForEachConcurrentCtx[T any](ctx context.Context, src []T, concurrency int,
+	fn func(ctx context.Context, idx int, t T) error) error {
+	if concurrency <= 0 {
+		return errs.NewErrInvalidSize(concurrency)
+	}
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next     atomic.Int64
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	workers := min(concurrency, len(src))
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for workerCtx.Err() == nil {
+				idx := int(next.Add(1) - 1)
+				if idx >= len(src) {
+					return
+				}
+				if err := fn(workerCtx, idx, src[idx]); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
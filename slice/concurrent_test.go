@@ -0,0 +1,90 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachConcurrentCtx(t *testing.T) {
+	src := make([]int, 100)
+	for i := range src {
+		src[i] = i
+	}
+
+	err := ForEachConcurrentCtx(context.Background(), src, 0,
+		func(ctx context.Context, idx int, t int) error { return nil })
+	assert.Equal(t, errs.NewErrInvalidSize(0), err)
+
+	// 所有元素都被处理
+	var (
+		mu   sync.Mutex
+		seen = make(map[int]int, len(src))
+	)
+	err = ForEachConcurrentCtx(context.Background(), src, 8,
+		func(ctx context.Context, idx int, t int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			seen[idx] = t
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, len(src), len(seen))
+	for idx, v := range seen {
+		assert.Equal(t, src[idx], v)
+	}
+
+	// nil 输入
+	err = ForEachConcurrentCtx(context.Background(), []int(nil), 8,
+		func(ctx context.Context, idx int, t int) error { return nil })
+	assert.NoError(t, err)
+}
+
+func TestForEachConcurrentCtx_Error(t *testing.T) {
+	mockErr := errors.New("mock error")
+	var processed atomic.Int64
+	src := make([]int, 1000)
+	err := ForEachConcurrentCtx(context.Background(), src, 4,
+		func(ctx context.Context, idx int, t int) error {
+			processed.Add(1)
+			if idx == 10 {
+				return mockErr
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Millisecond):
+				return nil
+			}
+		})
+	assert.Equal(t, mockErr, err)
+	assert.Less(t, processed.Load(), int64(len(src)))
+}
+
+func TestForEachConcurrentCtx_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var processed atomic.Int64
+	src := make([]int, 1000)
+	start := time.Now()
+	err := ForEachConcurrentCtx(ctx, src, 4,
+		func(ctx context.Context, idx int, t int) error {
+			if processed.Add(1) == 20 {
+				cancel()
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Millisecond):
+				return nil
+			}
+		})
+	assert.Equal(t, context.Canceled, err)
+	assert.Less(t, processed.Load(), int64(len(src)))
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,59 @@
+package slice
+
+// Contains 判断 src 中是否存在 target
+// 找到第一个匹配的元素之后立刻返回，nil 或者空切片返回 false
+func Contains[T comparable](src []T, target T) bool {
+	return ContainsFunc(src, func(t T) bool {
+		return t == target
+	})
+}
+
+// ContainsFunc 判断 src 中是否存在满足 equal 的元素
+// 可以用来按照结构体的某个字段查找，例如按照 ID 查找用户
+func ContainsFunc[T any](src []T, equal func(T) bool) bool {
+	for _, v := range src {
+		if equal(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAny 判断 src 中是否存在 targets 中的任意一个元素
+// targets 为空的时候返回 false
+func ContainsAny[T comparable](src, targets []T) bool {
+	if len(src) == 0 || len(targets) == 0 {
+		return false
+	}
+	set := toSet(targets)
+	for _, v := range src {
+		if _, ok := set[v]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll 判断 targets 中的所有元素是否都在 src 中
+// targets 为空的时候返回 true
+func ContainsAll[T comparable](src, targets []T) bool {
+	if len(targets) == 0 {
+		return true
+	}
+	set := toSet(src)
+	for _, v := range targets {
+		if _, ok := set[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// toSet 将切片转化为 map 表示的集合
+func toSet[T comparable](src []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(src))
+	for _, v := range src {
+		set[v] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,172 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContains(t *testing.T) {
+	testCases := []struct {
+		name   string
+		src    []int
+		target int
+		want   bool
+	}{
+		{
+			name:   "nil",
+			target: 1,
+			want:   false,
+		},
+		{
+			name:   "empty",
+			src:    []int{},
+			target: 1,
+			want:   false,
+		},
+		{
+			name:   "found",
+			src:    []int{1, 2, 3},
+			target: 2,
+			want:   true,
+		},
+		{
+			name:   "not found",
+			src:    []int{1, 2, 3},
+			target: 4,
+			want:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Contains(tc.src, tc.target))
+		})
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	testCases := []struct {
+		name string
+		src  []user
+		id   int
+		want bool
+	}{
+		{
+			name: "nil",
+			id:   1,
+			want: false,
+		},
+		{
+			name: "found",
+			src:  []user{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}},
+			id:   2,
+			want: true,
+		},
+		{
+			name: "not found",
+			src:  []user{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}},
+			id:   3,
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ContainsFunc(tc.src, func(u user) bool {
+				return u.ID == tc.id
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestContainsFunc_ShortCircuit(t *testing.T) {
+	cnt := 0
+	res := ContainsFunc([]int{1, 2, 3, 4}, func(v int) bool {
+		cnt++
+		return v == 2
+	})
+	assert.True(t, res)
+	assert.Equal(t, 2, cnt)
+}
+
+func TestContainsAny(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		targets []int
+		want    bool
+	}{
+		{
+			name:    "nil src",
+			targets: []int{1},
+			want:    false,
+		},
+		{
+			name: "nil targets",
+			src:  []int{1},
+			want: false,
+		},
+		{
+			name:    "one matched",
+			src:     []int{1, 2, 3},
+			targets: []int{5, 3},
+			want:    true,
+		},
+		{
+			name:    "none matched",
+			src:     []int{1, 2, 3},
+			targets: []int{4, 5},
+			want:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ContainsAny(tc.src, tc.targets))
+		})
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		targets []int
+		want    bool
+	}{
+		{
+			name:    "nil src",
+			targets: []int{1},
+			want:    false,
+		},
+		{
+			name: "nil targets",
+			src:  []int{1},
+			want: true,
+		},
+		{
+			name:    "all matched",
+			src:     []int{1, 2, 3},
+			targets: []int{3, 1, 3},
+			want:    true,
+		},
+		{
+			name:    "partially matched",
+			src:     []int{1, 2, 3},
+			targets: []int{1, 4},
+			want:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ContainsAll(tc.src, tc.targets))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package slice
+
+// Count 返回 src 中等于 target 的元素个数，src 为 nil 的时候返回 0
+func Count[T comparable](src []T, target T) int {
+	cnt := 0
+	for _, v := range src {
+		if v == target {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// CountFunc 返回 src 中满足 match 的元素个数，src 为 nil 的时候返回 0
+func CountFunc[T any](src []T, match func(T) bool) int {
+	cnt := 0
+	for _, v := range src {
+		if match(v) {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// CountMatchesMulti 只遍历一次 src，统计每一个 pred 匹配的元素个数
+// 返回结果的第 i 个元素就是 preds[i] 匹配的元素个数
+func CountMatchesMulti[T any](src []T, preds ...func(T) bool) []int {
+	res := make([]int, len(preds))
+	for _, v := range src {
+		for i, pred := range preds {
+			if pred(v) {
+				res[i]++
+			}
+		}
+	}
+	return res
+}
+
+// FilterByFrequency 返回在 src 中出现次数不少于 minCount 的元素
+// 保持元素原本的顺序，并且满足条件的元素每一次出现都会被保留
+func FilterByFrequency[T comparable](src []T, minCount int) []T {
+	counts := make(map[T]int, len(src))
+	for _, v := range src {
+		counts[v]++
+	}
+	res := make([]T, 0, len(src))
+	for _, v := range src {
+		if counts[v] >= minCount {
+			res = append(res, v)
+		}
+	}
+	return res
+}
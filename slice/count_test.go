@@ -0,0 +1,130 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		target   int
+		want     int
+		wantFunc int
+	}{
+		{
+			name:   "nil",
+			target: 1,
+		},
+		{
+			name:     "normal",
+			src:      []int{1, 2, 1, 3, 1, 4},
+			target:   1,
+			want:     3,
+			wantFunc: 2,
+		},
+		{
+			name:     "not found",
+			src:      []int{2, 3},
+			target:   1,
+			want:     0,
+			wantFunc: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Count(tc.src, tc.target))
+			res := CountFunc(tc.src, func(v int) bool {
+				return v%2 == 0
+			})
+			assert.Equal(t, tc.wantFunc, res)
+		})
+	}
+}
+
+func TestCount_NoAlloc(t *testing.T) {
+	src := []int{1, 2, 3, 1}
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = Count(src, 1)
+		_ = CountFunc(src, func(v int) bool { return v > 1 })
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func TestCountMatchesMulti(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	positive := func(v int) bool { return v > 0 }
+	gt10 := func(v int) bool { return v > 10 }
+	testCases := []struct {
+		name  string
+		src   []int
+		preds []func(int) bool
+		want  []int
+	}{
+		{
+			name:  "nil",
+			preds: []func(int) bool{even},
+			want:  []int{0},
+		},
+		{
+			name: "no preds",
+			src:  []int{1, 2},
+			want: []int{},
+		},
+		{
+			name:  "three preds",
+			src:   []int{-2, -1, 0, 1, 2, 11, 12},
+			preds: []func(int) bool{even, positive, gt10},
+			want:  []int{4, 4, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, CountMatchesMulti(tc.src, tc.preds...))
+		})
+	}
+}
+
+func TestFilterByFrequency(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		minCount int
+		want     []int
+	}{
+		{
+			name:     "nil",
+			minCount: 1,
+			want:     []int{},
+		},
+		{
+			name:     "drop single occurrence",
+			src:      []int{1, 2, 3, 2, 4, 1},
+			minCount: 2,
+			want:     []int{1, 2, 2, 1},
+		},
+		{
+			name:     "none qualified",
+			src:      []int{1, 2, 3},
+			minCount: 2,
+			want:     []int{},
+		},
+		{
+			name:     "min count 0",
+			src:      []int{1, 2, 3},
+			minCount: 0,
+			want:     []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FilterByFrequency(tc.src, tc.minCount)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package slice
+
+// Cursor 切片游标，用于在遍历过程中安全地删除元素
+// 手动在 for 循环里删除元素很容易漏掉紧随其后的元素，
+// Cursor 在内部维护读写两个下标，删除当前元素不会影响后续元素的遍历
+//
+// 注意：Cursor 会复用 src 的底层数组，遍历过程中会修改 src 的内容
+type Cursor[T any] struct {
+	data []T
+	// read 下一个要读取的元素下标
+	read int
+	// write 下一个保留元素要写入的位置
+	write int
+	// hasCur 上一次 Next 返回的元素是否还可以被 Remove
+	hasCur bool
+}
+
+// NewCursor 基于 src 创建一个游标
+func NewCursor[T any](src []T) *Cursor[T] {
+	return &Cursor[T]{
+		data: src,
+	}
+}
+
+// Next 返回下一个元素，没有更多元素的时候第二个返回值为 false
+func (c *Cursor[T]) Next() (T, bool) {
+	if c.read >= len(c.data) {
+		var zero T
+		c.hasCur = false
+		return zero, false
+	}
+	val := c.data[c.read]
+	c.data[c.write] = val
+	c.read++
+	c.write++
+	c.hasCur = true
+	return val, true
+}
+
+// Remove 删除上一次 Next 返回的元素
+// 在调用 Next 之前调用，或者对同一个元素重复调用，都不会产生任何效果
+func (c *Cursor[T]) Remove() {
+	if !c.hasCur {
+		return
+	}
+	c.write--
+	c.hasCur = false
+}
+
+// Result 返回剩余的元素，尚未遍历到的元素也会被保留
+// 调用 Result 之后依旧可以继续调用 Next 遍历尚未访问的元素
+func (c *Cursor[T]) Result() []T {
+	n := copy(c.data[c.write:], c.data[c.read:])
+	c.data = c.data[:c.write+n]
+	c.read = c.write
+	c.hasCur = false
+	return c.data
+}
@@ -0,0 +1,87 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	testCases := []struct {
+		name   string
+		src    []int
+		remove func(idx int, val int) bool
+		want   []int
+	}{
+		{
+			name:   "nil",
+			remove: func(idx int, val int) bool { return true },
+		},
+		{
+			name:   "remove every other",
+			src:    []int{0, 1, 2, 3, 4, 5, 6},
+			remove: func(idx int, val int) bool { return idx%2 == 1 },
+			want:   []int{0, 2, 4, 6},
+		},
+		{
+			name:   "remove adjacent",
+			src:    []int{1, 2, 2, 3, 2},
+			remove: func(idx int, val int) bool { return val == 2 },
+			want:   []int{1, 3},
+		},
+		{
+			name:   "remove all",
+			src:    []int{1, 2, 3},
+			remove: func(idx int, val int) bool { return true },
+			want:   []int{},
+		},
+		{
+			name:   "remove none",
+			src:    []int{1, 2, 3},
+			remove: func(idx int, val int) bool { return false },
+			want:   []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewCursor(tc.src)
+			visited := make([]int, 0, len(tc.src))
+			for idx := 0; ; idx++ {
+				val, ok := c.Next()
+				if !ok {
+					break
+				}
+				visited = append(visited, val)
+				if tc.remove(idx, val) {
+					c.Remove()
+				}
+			}
+			// 删除不会导致跳过任何元素
+			assert.Equal(t, len(tc.src), len(visited))
+			assert.Equal(t, tc.want, c.Result())
+		})
+	}
+}
+
+func TestCursor_Remove(t *testing.T) {
+	c := NewCursor([]int{1, 2, 3, 4})
+	// 尚未调用 Next
+	c.Remove()
+	val, ok := c.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 1, val)
+	c.Remove()
+	// 重复删除不会影响其他元素
+	c.Remove()
+	val, ok = c.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	// 提前获取结果，未遍历的元素会被保留
+	assert.Equal(t, []int{2, 3, 4}, c.Result())
+	val, ok = c.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 3, val)
+	c.Remove()
+	assert.Equal(t, []int{2, 4}, c.Result())
+}
@@ -0,0 +1,57 @@
+package slice
+
+// Unique 对 src 去重，保持元素第一次出现的顺序
+// 结果会预先分配 len(src) 的容量，只需要遍历一次
+func Unique[T comparable](src []T) []T {
+	return DeduplicateInto(src, make(map[T]struct{}, len(src)))
+}
+
+// UniqueFunc 按照 key 返回的值对 src 去重，保持元素第一次出现的顺序
+// 适用于 T 本身不可比较，但是有一个可比较的字段的场景
+// key 返回的值必须是可比较的，否则会 panic
+func UniqueFunc[T any](src []T, key func(T) any) []T {
+	seen := make(map[any]struct{}, len(src))
+	res := make([]T, 0, len(src))
+	for _, v := range src {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		res = append(res, v)
+	}
+	return res
+}
+
+// DeduplicateInto 使用外部传入的 seen 集合对 src 去重，保持元素第一次出现的顺序
+// 出现在 seen 中的元素会被丢弃，新元素会被加入 seen
+// 因此多次调用共享同一个 seen，可以实现跨多个切片的去重，例如分页拉取数据时对每一页去重；
+// 传入一个新的空 map 就等价于普通的去重
+// seen 不能为 nil
+func DeduplicateInto[T comparable](src []T, seen map[T]struct{}) []T {
+	res := make([]T, 0, len(src))
+	for _, v := range src {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		res = append(res, v)
+	}
+	return res
+}
+
+// DeduplicateSortedFunc 对已经排好序的 src 去重，返回一个新的切片
+// 如果当前元素和上一个保留下来的元素满足 equal，那么当前元素会被丢弃，
+// 因此每一组相等（或者在 equal 的意义上相近）的元素只保留第一个作为代表
+// 只需要遍历一次，并且不需要 map，调用者需要保证相等的元素在 src 中是相邻的，
+// 即 src 已经按照和 equal 一致的规则排过序，否则无法去除不相邻的重复元素
+func DeduplicateSortedFunc[T any](src []T, equal func(a, b T) bool) []T {
+	res := make([]T, 0, len(src))
+	for _, v := range src {
+		if len(res) > 0 && equal(res[len(res)-1], v) {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,152 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "no duplicate",
+			src:  []int{3, 1, 2},
+			want: []int{3, 1, 2},
+		},
+		{
+			name: "first occurrence wins",
+			src:  []int{3, 1, 3, 2, 1, 3},
+			want: []int{3, 1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Unique(tc.src))
+		})
+	}
+}
+
+func TestUniqueFunc(t *testing.T) {
+	type item struct {
+		ID   int
+		Tags []string
+	}
+	testCases := []struct {
+		name string
+		src  []item
+		want []item
+	}{
+		{
+			name: "nil",
+			want: []item{},
+		},
+		{
+			name: "first occurrence wins",
+			src: []item{
+				{ID: 1, Tags: []string{"a"}},
+				{ID: 2, Tags: []string{"b"}},
+				{ID: 1, Tags: []string{"c"}},
+			},
+			want: []item{
+				{ID: 1, Tags: []string{"a"}},
+				{ID: 2, Tags: []string{"b"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := UniqueFunc(tc.src, func(t item) any {
+				return t.ID
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestDeduplicateInto(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		seen map[int]struct{}
+		want []int
+	}{
+		{
+			name: "nil",
+			seen: map[int]struct{}{},
+			want: []int{},
+		},
+		{
+			name: "fresh seen",
+			src:  []int{3, 1, 3, 2, 1},
+			seen: map[int]struct{}{},
+			want: []int{3, 1, 2},
+		},
+		{
+			name: "seen before",
+			src:  []int{3, 1, 3, 2, 1},
+			seen: map[int]struct{}{1: {}},
+			want: []int{3, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := DeduplicateInto(tc.src, tc.seen)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestDeduplicateInto_Pages(t *testing.T) {
+	seen := make(map[int]struct{})
+	page1 := DeduplicateInto([]int{1, 2, 2, 3}, seen)
+	page2 := DeduplicateInto([]int{3, 4, 1, 5, 4}, seen)
+	assert.Equal(t, []int{1, 2, 3}, page1)
+	assert.Equal(t, []int{4, 5}, page2)
+	assert.Equal(t, 5, len(seen))
+}
+
+func TestDeduplicateSortedFunc(t *testing.T) {
+	testCases := []struct {
+		name  string
+		src   []float64
+		equal func(a, b float64) bool
+		want  []float64
+	}{
+		{
+			name:  "nil",
+			equal: func(a, b float64) bool { return a == b },
+			want:  []float64{},
+		},
+		{
+			name:  "exact duplicates",
+			src:   []float64{1, 1, 2, 3, 3, 3},
+			equal: func(a, b float64) bool { return a == b },
+			want:  []float64{1, 2, 3},
+		},
+		{
+			name: "near duplicates",
+			src:  []float64{1.0, 1.05, 1.09, 2.0, 2.01, 3.5},
+			equal: func(a, b float64) bool {
+				return b-a < 0.1
+			},
+			want: []float64{1.0, 2.0, 3.5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DeduplicateSortedFunc(tc.src, tc.equal))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package slice
+
+import (
+	"sort"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/lhh-gh/ekit/internal/slice"
+)
+
+// DeleteSafe 删除 index 处的元素，并返回被删除的元素
+// 删除之后会将底层数组中空出来的位置置为零值，避免指针类型的元素无法被回收
+// index 范围应为[0, len(src))
+func DeleteSafe[Src any](src []Src, index int) ([]Src, Src, error) {
+	return slice.DeleteSafe[Src](src, index)
+}
+
+// DeleteRange 删除 [start, end) 区间内的元素
+// 需要满足 0 <= start <= end <= len(src)
+// 被截断的尾部位置会被置为零值
+func DeleteRange[Src any](src []Src, start, end int) ([]Src, error) {
+	return slice.DeleteRange[Src](src, start, end)
+}
+
+// RemoveAt 删除 indices 指定的所有位置上的元素，返回一个新的切片
+// indices 可以是无序的，也可以包含重复的下标，每一个下标都必须在 [0, len(src)) 之间，
+// 否则返回错误
+// 和多次调用 Delete 相比，RemoveAt 只需要遍历一次，并且不需要考虑删除之后下标的变化
+func RemoveAt[T any](src []T, indices ...int) ([]T, error) {
+	sorted := make([]int, len(indices))
+	copy(sorted, indices)
+	sort.Ints(sorted)
+	for _, idx := range sorted {
+		if idx < 0 || idx >= len(src) {
+			return nil, errs.NewErrIndexOutOfRange(len(src), idx)
+		}
+	}
+	res := make([]T, 0, len(src))
+	j := 0
+	for i, v := range src {
+		if j < len(sorted) && sorted[j] == i {
+			// 跳过重复的下标
+			for j < len(sorted) && sorted[j] == i {
+				j++
+			}
+			continue
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// DeleteValue 删除第一个等于 value 的元素，第二个返回值表示是否删除了元素
+// 没有找到的时候原样返回 src
+// 被截断的尾部位置会被置为零值
+func DeleteValue[T comparable](src []T, value T) ([]T, bool) {
+	idx := Index(src, value)
+	if idx < 0 {
+		return src, false
+	}
+	res, _, _ := slice.DeleteSafe[T](src, idx)
+	return res, true
+}
+
+// DeleteAllValues 删除所有等于 value 的元素，只遍历一次，并复用 src 的底层数组
+// 剩余的元素保持原本的顺序，被截断的尾部位置会被置为零值
+func DeleteAllValues[T comparable](src []T, value T) []T {
+	return DeleteFunc(src, func(t T) bool {
+		return t == value
+	})
+}
+
+// DeleteFunc 删除所有满足 match 的元素，语义和标准库的 slices.DeleteFunc 一致
+// 只遍历一次，并复用 src 的底层数组，剩余的元素保持原本的顺序
+// 被截断的尾部位置会被置为零值，避免继续引用已经删除的对象，例如淘汰过期的缓存项
+func DeleteFunc[T any](src []T, match func(T) bool) []T {
+	pos := 0
+	for _, v := range src {
+		if !match(v) {
+			src[pos] = v
+			pos++
+		}
+	}
+	var zero T
+	for i := pos; i < len(src); i++ {
+		src[i] = zero
+	}
+	return src[:pos]
+}
@@ -0,0 +1,284 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteSafe(t *testing.T) {
+	// DeleteSafe 主要依赖于 internal/slice.DeleteSafe 来保证正确性
+	testCases := []struct {
+		name      string
+		slice     []int
+		index     int
+		wantSlice []int
+		wantVal   int
+		wantErr   error
+	}{
+		{
+			name:      "index 0",
+			slice:     []int{123, 100},
+			index:     0,
+			wantSlice: []int{100},
+			wantVal:   123,
+		},
+		{
+			name:    "index -1",
+			slice:   []int{123, 100},
+			index:   -1,
+			wantErr: errs.NewErrIndexOutOfRange(2, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, val, err := DeleteSafe(tc.slice, tc.index)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+			assert.Equal(t, tc.wantVal, val)
+		})
+	}
+}
+
+func TestDeleteRange(t *testing.T) {
+	// DeleteRange 主要依赖于 internal/slice.DeleteRange 来保证正确性
+	testCases := []struct {
+		name      string
+		slice     []int
+		start     int
+		end       int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "middle",
+			slice:     []int{1, 2, 3, 4},
+			start:     1,
+			end:       3,
+			wantSlice: []int{1, 4},
+		},
+		{
+			name:    "end out of range",
+			slice:   []int{1, 2},
+			start:   0,
+			end:     3,
+			wantErr: errs.NewErrInvalidRange(2, 0, 3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DeleteRange(tc.slice, tc.start, tc.end)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestRemoveAt(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		indices []int
+		want    []int
+		wantErr error
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "no indices",
+			src:  []int{1, 2, 3},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "non-contiguous",
+			src:     []int{0, 1, 2, 3, 4, 5},
+			indices: []int{4, 1, 5},
+			want:    []int{0, 2, 3},
+		},
+		{
+			name:    "duplicate index",
+			src:     []int{0, 1, 2, 3},
+			indices: []int{2, 0, 2},
+			want:    []int{1, 3},
+		},
+		{
+			name:    "all",
+			src:     []int{0, 1, 2},
+			indices: []int{0, 1, 2},
+			want:    []int{},
+		},
+		{
+			name:    "index out of range",
+			src:     []int{0, 1, 2},
+			indices: []int{1, 3},
+			wantErr: errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:    "index -1",
+			src:     []int{0, 1, 2},
+			indices: []int{1, -1},
+			wantErr: errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := RemoveAt(tc.src, tc.indices...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestDeleteValue(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		value     int
+		wantSlice []int
+		wantOk    bool
+	}{
+		{
+			name:  "nil",
+			value: 1,
+		},
+		{
+			name:      "first match only",
+			slice:     []int{1, 2, 3, 2},
+			value:     2,
+			wantSlice: []int{1, 3, 2},
+			wantOk:    true,
+		},
+		{
+			name:      "not found",
+			slice:     []int{1, 2, 3},
+			value:     4,
+			wantSlice: []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := DeleteValue(tc.slice, tc.value)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestDeleteAllValues(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		value     int
+		wantSlice []int
+	}{
+		{
+			name:  "nil",
+			value: 1,
+		},
+		{
+			name:      "all matches",
+			slice:     []int{2, 1, 2, 3, 2},
+			value:     2,
+			wantSlice: []int{1, 3},
+		},
+		{
+			name:      "not found",
+			slice:     []int{1, 2, 3},
+			value:     4,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "every element",
+			slice:     []int{2, 2},
+			value:     2,
+			wantSlice: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := DeleteAllValues(tc.slice, tc.value)
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestDeleteAllValues_Pointer(t *testing.T) {
+	a, b := 1, 2
+	src := []*int{&a, nil, &b, nil}
+	res := DeleteAllValues(src, nil)
+	assert.Equal(t, []*int{&a, &b}, res)
+	assert.Nil(t, src[2])
+	assert.Nil(t, src[3])
+}
+
+func TestDeleteFunc(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "some match",
+			slice:     []int{1, 2, 3, 4, 5},
+			wantSlice: []int{1, 3, 5},
+		},
+		{
+			name:      "all match",
+			slice:     []int{2, 4, 6},
+			wantSlice: []int{},
+		},
+		{
+			name:      "none match",
+			slice:     []int{1, 3, 5},
+			wantSlice: []int{1, 3, 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			length := len(tc.slice)
+			res := DeleteFunc(tc.slice, func(v int) bool {
+				return v%2 == 0
+			})
+			assert.Equal(t, tc.wantSlice, res)
+			for _, v := range tc.slice[len(res):length] {
+				assert.Equal(t, 0, v)
+			}
+		})
+	}
+}
+
+func TestDeleteFunc_Expired(t *testing.T) {
+	type entry struct {
+		Key     string
+		Expired bool
+	}
+	a, b, c := &entry{Key: "a"}, &entry{Key: "b", Expired: true}, &entry{Key: "c"}
+	src := []*entry{a, b, c}
+	res := DeleteFunc(src, func(e *entry) bool {
+		return e.Expired
+	})
+	assert.Equal(t, []*entry{a, c}, res)
+	// 被删除的缓存项不再被底层数组引用
+	assert.Nil(t, src[2])
+}
@@ -0,0 +1,58 @@
+package slice
+
+// DropFirst 返回去掉前 n 个元素之后的切片
+// n <= 0 时返回 src 的副本，n >= len(src) 时返回空切片
+// 返回的切片总是一个新的切片，不会和 src 共享底层数组
+func DropFirst[T any](src []T, n int) []T {
+	n = max(0, min(n, len(src)))
+	res := make([]T, len(src)-n)
+	copy(res, src[n:])
+	return res
+}
+
+// DropLast 返回去掉后 n 个元素之后的切片
+// n <= 0 时返回 src 的副本，n >= len(src) 时返回空切片
+// 返回的切片总是一个新的切片，不会和 src 共享底层数组
+func DropLast[T any](src []T, n int) []T {
+	n = max(0, min(n, len(src)))
+	res := make([]T, len(src)-n)
+	copy(res, src)
+	return res
+}
+
+// Take 返回前 n 个元素组成的新切片
+// n 会被限制在 [0, len(src)] 之间，不会 panic；返回的切片不会和 src 共享底层数组
+func Take[T any](src []T, n int) []T {
+	n = max(0, min(n, len(src)))
+	res := make([]T, n)
+	copy(res, src)
+	return res
+}
+
+// Drop 返回去掉前 n 个元素之后的新切片，等价于 DropFirst
+// n 会被限制在 [0, len(src)] 之间，不会 panic
+func Drop[T any](src []T, n int) []T {
+	return DropFirst(src, n)
+}
+
+// TakeWhile 从头开始返回满足 pred 的元素，遇到第一个不满足 pred 的元素时停止
+// 返回的切片不会和 src 共享底层数组
+func TakeWhile[T any](src []T, pred func(T) bool) []T {
+	return Take(src, countWhile(src, pred))
+}
+
+// DropWhile 从头开始丢弃满足 pred 的元素，返回从第一个不满足 pred 的元素开始的剩余部分
+// 例如去掉解析结果开头的表头行；返回的切片不会和 src 共享底层数组
+func DropWhile[T any](src []T, pred func(T) bool) []T {
+	return Drop(src, countWhile(src, pred))
+}
+
+// countWhile 返回从头开始连续满足 pred 的元素个数
+func countWhile[T any](src []T, pred func(T) bool) int {
+	for i, v := range src {
+		if !pred(v) {
+			return i
+		}
+	}
+	return len(src)
+}
@@ -0,0 +1,201 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDropFirst(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		n    int
+		want []int
+	}{
+		{
+			name: "nil",
+			n:    1,
+			want: []int{},
+		},
+		{
+			name: "n 0",
+			src:  []int{1, 2, 3},
+			n:    0,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "n negative",
+			src:  []int{1, 2, 3},
+			n:    -1,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "n in range",
+			src:  []int{1, 2, 3},
+			n:    2,
+			want: []int{3},
+		},
+		{
+			name: "n larger than len",
+			src:  []int{1, 2, 3},
+			n:    5,
+			want: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := DropFirst(tc.src, tc.n)
+			assert.Equal(t, tc.want, res)
+			if len(res) > 0 {
+				res[0] = 100
+				assert.NotEqual(t, 100, tc.src[len(tc.src)-len(res)])
+			}
+		})
+	}
+}
+
+func TestDropLast(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		n    int
+		want []int
+	}{
+		{
+			name: "nil",
+			n:    1,
+			want: []int{},
+		},
+		{
+			name: "n 0",
+			src:  []int{1, 2, 3},
+			n:    0,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "n negative",
+			src:  []int{1, 2, 3},
+			n:    -1,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "n in range",
+			src:  []int{1, 2, 3},
+			n:    2,
+			want: []int{1},
+		},
+		{
+			name: "n larger than len",
+			src:  []int{1, 2, 3},
+			n:    5,
+			want: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := DropLast(tc.src, tc.n)
+			assert.Equal(t, tc.want, res)
+			if len(res) > 0 {
+				res[0] = 100
+				assert.NotEqual(t, 100, tc.src[0])
+			}
+		})
+	}
+}
+
+func TestTakeDrop(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		n        int
+		wantTake []int
+		wantDrop []int
+	}{
+		{
+			name:     "nil",
+			n:        1,
+			wantTake: []int{},
+			wantDrop: []int{},
+		},
+		{
+			name:     "n less than 0",
+			src:      []int{1, 2, 3},
+			n:        -1,
+			wantTake: []int{},
+			wantDrop: []int{1, 2, 3},
+		},
+		{
+			name:     "normal",
+			src:      []int{1, 2, 3},
+			n:        2,
+			wantTake: []int{1, 2},
+			wantDrop: []int{3},
+		},
+		{
+			name:     "n larger than len",
+			src:      []int{1, 2, 3},
+			n:        5,
+			wantTake: []int{1, 2, 3},
+			wantDrop: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantTake, Take(tc.src, tc.n))
+			assert.Equal(t, tc.wantDrop, Drop(tc.src, tc.n))
+		})
+	}
+}
+
+func TestTakeWhileDropWhile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		wantTake []int
+		wantDrop []int
+	}{
+		{
+			name:     "nil",
+			wantTake: []int{},
+			wantDrop: []int{},
+		},
+		{
+			name:     "stop at first flip",
+			src:      []int{1, 2, 5, 1, 2},
+			wantTake: []int{1, 2},
+			wantDrop: []int{5, 1, 2},
+		},
+		{
+			name:     "all matched",
+			src:      []int{1, 2},
+			wantTake: []int{1, 2},
+			wantDrop: []int{},
+		},
+		{
+			name:     "none matched",
+			src:      []int{5, 1},
+			wantTake: []int{},
+			wantDrop: []int{5, 1},
+		},
+	}
+
+	less3 := func(v int) bool { return v < 3 }
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantTake, TakeWhile(tc.src, less3))
+			assert.Equal(t, tc.wantDrop, DropWhile(tc.src, less3))
+		})
+	}
+}
+
+func TestTake_Independent(t *testing.T) {
+	src := []int{1, 2, 3}
+	res := Take(src, 2)
+	res[0] = 10
+	_ = append(res, 20)
+	assert.Equal(t, []int{1, 2, 3}, src)
+}
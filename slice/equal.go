@@ -0,0 +1,83 @@
+package slice
+
+// Equal 判断 a 和 b 是否长度相同并且对应位置的元素都相等
+// 长度不同的时候直接返回 false
+// 注意：只比较长度和元素，因此 nil 和非 nil 的空切片被认为是相等的
+func Equal[T comparable](a, b []T) bool {
+	return EqualFunc(a, b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// EqualFunc 和 Equal 一样，只是使用 eq 来比较对应位置的元素
+// nil 和非 nil 的空切片同样被认为是相等的
+func EqualFunc[T any](a, b []T, eq func(a, b T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPermutationOf 判断 b 是否是 a 的一个排列，即两者包含的元素以及每个元素出现的次数都完全相同
+// 可以用来断言打乱、并发处理之后没有丢失或者重复元素
+func IsPermutationOf[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		cnt := counts[v]
+		if cnt == 0 {
+			return false
+		}
+		counts[v] = cnt - 1
+	}
+	return true
+}
+
+// EqualByKey 按位置逐个比较 a 和 b，只比较 key 派生出来的值，忽略其他字段
+// 例如按照主键比较两个数据库查询结果，主键一致即认为相等
+func EqualByKey[T any, K comparable](a, b []T, key func(T) K) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if key(a[i]) != key(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffIndices 按位置比较 oldSrc 和 newSrc，返回元素不同的下标，下标升序排列
+// 先比较两者共同长度内的元素，较长的切片多出来的部分的下标也会被返回
+// 例如只重新渲染发生变化的列表项；关心的是位置上的变化，而不是集合意义上的差异
+func DiffIndices[T comparable](oldSrc, newSrc []T) []int {
+	return DiffIndicesFunc(oldSrc, newSrc, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// DiffIndicesFunc 和 DiffIndices 一样，只是使用 eq 判断相同位置的元素是否相等
+// 例如按照 ID 比较结构体
+func DiffIndicesFunc[T any](oldSrc, newSrc []T, eq func(a, b T) bool) []int {
+	n := min(len(oldSrc), len(newSrc))
+	res := make([]int, 0)
+	for i := 0; i < n; i++ {
+		if !eq(oldSrc[i], newSrc[i]) {
+			res = append(res, i)
+		}
+	}
+	for i := n; i < max(len(oldSrc), len(newSrc)); i++ {
+		res = append(res, i)
+	}
+	return res
+}
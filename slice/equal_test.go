@@ -0,0 +1,241 @@
+package slice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []int
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "nil and empty",
+			a:    nil,
+			b:    []int{},
+			want: true,
+		},
+		{
+			name: "length mismatch",
+			a:    []int{1, 2},
+			b:    []int{1, 2, 3},
+			want: false,
+		},
+		{
+			name: "equal",
+			a:    []int{1, 2, 3},
+			b:    []int{1, 2, 3},
+			want: true,
+		},
+		{
+			name: "different order",
+			a:    []int{1, 2, 3},
+			b:    []int{1, 3, 2},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Equal(tc.a, tc.b))
+			assert.Equal(t, tc.want, Equal(tc.b, tc.a))
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "nil and empty",
+			b:    []string{},
+			want: true,
+		},
+		{
+			name: "length mismatch",
+			a:    []string{"a"},
+			b:    []string{"a", "b"},
+			want: false,
+		},
+		{
+			name: "equal ignoring case",
+			a:    []string{"a", "B"},
+			b:    []string{"A", "b"},
+			want: true,
+		},
+		{
+			name: "not equal",
+			a:    []string{"a", "b"},
+			b:    []string{"a", "c"},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := EqualFunc(tc.a, tc.b, strings.EqualFold)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestIsPermutationOf(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []int
+		want bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name: "permutation",
+			a:    []int{1, 2, 2, 3},
+			b:    []int{2, 3, 1, 2},
+			want: true,
+		},
+		{
+			name: "length mismatch",
+			a:    []int{1, 2, 3},
+			b:    []int{1, 2},
+			want: false,
+		},
+		{
+			name: "count mismatch",
+			a:    []int{1, 2, 2},
+			b:    []int{1, 1, 2},
+			want: false,
+		},
+		{
+			name: "different elements",
+			a:    []int{1, 2, 3},
+			b:    []int{1, 2, 4},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsPermutationOf(tc.a, tc.b))
+		})
+	}
+}
+
+func TestEqualByKey(t *testing.T) {
+	type row struct {
+		ID   int
+		Name string
+	}
+	testCases := []struct {
+		name string
+		a    []row
+		b    []row
+		want bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name: "equal by key but different fields",
+			a:    []row{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}},
+			b:    []row{{ID: 1, Name: "Tommy"}, {ID: 2}},
+			want: true,
+		},
+		{
+			name: "different order",
+			a:    []row{{ID: 1}, {ID: 2}},
+			b:    []row{{ID: 2}, {ID: 1}},
+			want: false,
+		},
+		{
+			name: "length mismatch",
+			a:    []row{{ID: 1}, {ID: 2}},
+			b:    []row{{ID: 1}},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := EqualByKey(tc.a, tc.b, func(r row) int { return r.ID })
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestDiffIndices(t *testing.T) {
+	testCases := []struct {
+		name   string
+		oldSrc []int
+		newSrc []int
+		want   []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name:   "same",
+			oldSrc: []int{1, 2, 3},
+			newSrc: []int{1, 2, 3},
+			want:   []int{},
+		},
+		{
+			name:   "changed",
+			oldSrc: []int{1, 2, 3, 4},
+			newSrc: []int{1, 5, 3, 6},
+			want:   []int{1, 3},
+		},
+		{
+			name:   "new longer",
+			oldSrc: []int{1, 2},
+			newSrc: []int{1, 3, 4, 5},
+			want:   []int{1, 2, 3},
+		},
+		{
+			name:   "old longer",
+			oldSrc: []int{1, 2, 3},
+			newSrc: []int{1},
+			want:   []int{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DiffIndices(tc.oldSrc, tc.newSrc))
+		})
+	}
+}
+
+func TestDiffIndicesFunc(t *testing.T) {
+	type item struct {
+		ID    int
+		Title string
+	}
+	oldSrc := []item{{1, "a"}, {2, "b"}, {3, "c"}}
+	newSrc := []item{{1, "a2"}, {4, "d"}, {3, "c"}}
+	res := DiffIndicesFunc(oldSrc, newSrc, func(a, b item) bool {
+		return a.ID == b.ID
+	})
+	assert.Equal(t, []int{1}, res)
+}
@@ -0,0 +1,49 @@
+package slice
+
+// Filter 返回满足 match 的元素组成的新切片，不会修改 src
+// src 为 nil 的时候返回 nil
+func Filter[T any](src []T, match func(idx int, src T) bool) []T {
+	if src == nil {
+		return nil
+	}
+	res := make([]T, 0, len(src))
+	for i, v := range src {
+		if match(i, v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// FilterDelete 原地过滤，只保留满足 match 的元素，复用 src 的底层数组
+// 调用之后 src 不应该再被使用，应该使用返回值
+// 被丢弃的尾部位置会被置为零值，避免指针类型的元素无法被回收
+func FilterDelete[T any](src []T, match func(idx int, src T) bool) []T {
+	pos := 0
+	for i, v := range src {
+		if match(i, v) {
+			src[pos] = v
+			pos++
+		}
+	}
+	var zero T
+	for i := pos; i < len(src); i++ {
+		src[i] = zero
+	}
+	return src[:pos]
+}
+
+// FilterIndexed 返回满足 match 的元素，以及这些元素在 src 中原本的下标
+// 两个返回值一一对应：src[indices[i]] == res[i]
+// 适用于过滤之后还需要回到原始位置的场景，例如更新对应的数据库记录
+func FilterIndexed[T any](src []T, match func(idx int, t T) bool) ([]T, []int) {
+	res := make([]T, 0, len(src))
+	indices := make([]int, 0, len(src))
+	for i, v := range src {
+		if match(i, v) {
+			res = append(res, v)
+			indices = append(indices, i)
+		}
+	}
+	return res, indices
+}
@@ -0,0 +1,141 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "empty",
+			src:  []int{},
+			want: []int{},
+		},
+		{
+			name: "normal",
+			src:  []int{1, 2, 3, 4, 5},
+			want: []int{2, 4},
+		},
+		{
+			name: "none",
+			src:  []int{1, 3},
+			want: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Filter(tc.src, func(idx int, src int) bool {
+				return src%2 == 0
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestFilterDelete(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "normal",
+			src:  []int{1, 2, 3, 4, 5},
+			want: []int{2, 4},
+		},
+		{
+			name: "all kept",
+			src:  []int{2, 4},
+			want: []int{2, 4},
+		},
+		{
+			name: "none",
+			src:  []int{1, 3},
+			want: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			length := len(tc.src)
+			res := FilterDelete(tc.src, func(idx int, src int) bool {
+				return src%2 == 0
+			})
+			assert.Equal(t, tc.want, res)
+			// 复用了底层数组，丢弃的位置都是零值
+			for _, v := range tc.src[len(res):length] {
+				assert.Equal(t, 0, v)
+			}
+		})
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	testCases := []struct {
+		name        string
+		src         []int
+		match       func(idx int, t int) bool
+		want        []int
+		wantIndices []int
+	}{
+		{
+			name: "nil",
+			match: func(idx int, t int) bool {
+				return true
+			},
+			want:        []int{},
+			wantIndices: []int{},
+		},
+		{
+			name: "even values",
+			src:  []int{1, 2, 3, 4, 5, 6},
+			match: func(idx int, t int) bool {
+				return t%2 == 0
+			},
+			want:        []int{2, 4, 6},
+			wantIndices: []int{1, 3, 5},
+		},
+		{
+			name: "by index",
+			src:  []int{10, 20, 30, 40},
+			match: func(idx int, t int) bool {
+				return idx >= 2
+			},
+			want:        []int{30, 40},
+			wantIndices: []int{2, 3},
+		},
+		{
+			name: "none",
+			src:  []int{1, 3, 5},
+			match: func(idx int, t int) bool {
+				return t%2 == 0
+			},
+			want:        []int{},
+			wantIndices: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, indices := FilterIndexed(tc.src, tc.match)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.wantIndices, indices)
+			for i, idx := range indices {
+				assert.Equal(t, tc.src[idx], res[i])
+			}
+		})
+	}
+}
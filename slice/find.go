@@ -0,0 +1,25 @@
+package slice
+
+// Find 返回第一个满足 match 的元素
+// 找不到时返回零值和 false
+func Find[T any](src []T, match func(T) bool) (T, bool) {
+	for _, v := range src {
+		if match(v) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// FindLast 从后往前查找，返回最后一个满足 match 的元素
+// 找不到时返回零值和 false
+func FindLast[T any](src []T, match func(T) bool) (T, bool) {
+	for i := len(src) - 1; i >= 0; i-- {
+		if match(src[i]) {
+			return src[i], true
+		}
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,91 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		match     func(int) bool
+		wantVal   int
+		wantFound bool
+	}{
+		{
+			name:  "nil",
+			match: func(v int) bool { return true },
+		},
+		{
+			name:      "multiple matches",
+			src:       []int{1, 2, 3, 4, 5},
+			match:     func(v int) bool { return v%2 == 0 },
+			wantVal:   2,
+			wantFound: true,
+		},
+		{
+			name:      "first element",
+			src:       []int{1, 2, 3},
+			match:     func(v int) bool { return v > 0 },
+			wantVal:   1,
+			wantFound: true,
+		},
+		{
+			name:  "not found",
+			src:   []int{1, 2, 3},
+			match: func(v int) bool { return v > 10 },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, found := Find(tc.src, tc.match)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.wantVal, val)
+		})
+	}
+}
+
+func TestFindLast(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		match     func(int) bool
+		wantVal   int
+		wantFound bool
+	}{
+		{
+			name:  "nil",
+			match: func(v int) bool { return true },
+		},
+		{
+			name:      "multiple matches",
+			src:       []int{1, 2, 3, 4, 5},
+			match:     func(v int) bool { return v%2 == 0 },
+			wantVal:   4,
+			wantFound: true,
+		},
+		{
+			name:      "last element",
+			src:       []int{1, 2, 3},
+			match:     func(v int) bool { return v > 0 },
+			wantVal:   3,
+			wantFound: true,
+		},
+		{
+			name:  "not found",
+			src:   []int{1, 2, 3},
+			match: func(v int) bool { return v > 10 },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, found := FindLast(tc.src, tc.match)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.wantVal, val)
+		})
+	}
+}
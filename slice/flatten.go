@@ -0,0 +1,22 @@
+package slice
+
+// Flatten 将多个切片按照顺序拼接为一个切片
+// 会先计算出总长度，只分配一次内存；nil 的内层切片会被跳过
+// 适合将每个分片的查询结果合并为一个列表
+func Flatten[T any](src [][]T) []T {
+	return Concat(src...)
+}
+
+// Concat 和 Flatten 一样，只是以可变参数的形式传入切片
+// 例如 Concat(a, b, c)
+func Concat[T any](slices ...[]T) []T {
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+	res := make([]T, 0, total)
+	for _, s := range slices {
+		res = append(res, s...)
+	}
+	return res
+}
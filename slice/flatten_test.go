@@ -0,0 +1,55 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  [][]int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "nil inner",
+			src:  [][]int{nil, {1, 2}, nil, {3}, nil},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "all empty",
+			src:  [][]int{{}, nil},
+			want: []int{},
+		},
+		{
+			name: "normal",
+			src:  [][]int{{1}, {2, 3}, {4, 5, 6}},
+			want: []int{1, 2, 3, 4, 5, 6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Flatten(tc.src)
+			assert.Equal(t, tc.want, res)
+			// 只分配一次，容量恰好等于长度
+			assert.Equal(t, len(res), cap(res))
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	a, b := []int{1, 2}, []int{3}
+	res := Concat(a, nil, b)
+	assert.Equal(t, []int{1, 2, 3}, res)
+	// 修改结果不会影响输入
+	res[0] = 10
+	assert.Equal(t, []int{1, 2}, a)
+
+	assert.Equal(t, []int{}, Concat[int]())
+}
@@ -0,0 +1,32 @@
+package slice
+
+import "github.com/lhh-gh/ekit/internal/errs"
+
+// Get 返回 index 处的元素
+// index 范围应为[0, len(src))，超出范围时返回错误而不是 panic
+func Get[T any](src []T, index int) (T, error) {
+	if index < 0 || index >= len(src) {
+		var zero T
+		return zero, errs.NewErrIndexOutOfRange(len(src), index)
+	}
+	return src[index], nil
+}
+
+// GetOrDefault 返回 index 处的元素，index 超出 [0, len(src)) 范围时返回 def
+// 适合解析长度不固定的记录，例如可选的末尾字段
+func GetOrDefault[T any](src []T, index int, def T) T {
+	if index < 0 || index >= len(src) {
+		return def
+	}
+	return src[index]
+}
+
+// Set 将 index 处的元素设置为 val
+// index 范围应为[0, len(src))，超出范围时返回错误而不是 panic
+func Set[T any](src []T, index int, val T) error {
+	if index < 0 || index >= len(src) {
+		return errs.NewErrIndexOutOfRange(len(src), index)
+	}
+	src[index] = val
+	return nil
+}
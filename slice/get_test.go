@@ -0,0 +1,141 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		index   int
+		want    int
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			index:   0,
+			wantErr: errs.NewErrIndexOutOfRange(0, 0),
+		},
+		{
+			name:    "index -1",
+			src:     []int{1, 2},
+			index:   -1,
+			wantErr: errs.NewErrIndexOutOfRange(2, -1),
+		},
+		{
+			name:    "index out of range",
+			src:     []int{1, 2},
+			index:   2,
+			wantErr: errs.NewErrIndexOutOfRange(2, 2),
+		},
+		{
+			name:  "index 0",
+			src:   []int{1, 2},
+			index: 0,
+			want:  1,
+		},
+		{
+			name:  "index last",
+			src:   []int{1, 2},
+			index: 1,
+			want:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Get(tc.src, tc.index)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestGetOrDefault(t *testing.T) {
+	testCases := []struct {
+		name  string
+		src   []string
+		index int
+		want  string
+	}{
+		{
+			name:  "nil",
+			index: 0,
+			want:  "default",
+		},
+		{
+			name:  "in range",
+			src:   []string{"a", "b"},
+			index: 1,
+			want:  "b",
+		},
+		{
+			name:  "index out of range",
+			src:   []string{"a", "b"},
+			index: 2,
+			want:  "default",
+		},
+		{
+			name:  "index -1",
+			src:   []string{"a", "b"},
+			index: -1,
+			want:  "default",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GetOrDefault(tc.src, tc.index, "default"))
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		index     int
+		val       int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:    "nil",
+			index:   0,
+			wantErr: errs.NewErrIndexOutOfRange(0, 0),
+		},
+		{
+			name:      "index -1",
+			src:       []int{1, 2},
+			index:     -1,
+			wantSlice: []int{1, 2},
+			wantErr:   errs.NewErrIndexOutOfRange(2, -1),
+		},
+		{
+			name:      "index out of range",
+			src:       []int{1, 2},
+			index:     2,
+			wantSlice: []int{1, 2},
+			wantErr:   errs.NewErrIndexOutOfRange(2, 2),
+		},
+		{
+			name:      "index middle",
+			src:       []int{1, 2, 3},
+			index:     1,
+			val:       100,
+			wantSlice: []int{1, 100, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Set(tc.src, tc.index, tc.val)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantSlice, tc.src)
+		})
+	}
+}
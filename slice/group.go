@@ -0,0 +1,41 @@
+package slice
+
+import "time"
+
+// GroupBy 按照 keyFunc 返回的 key 对元素进行分组，每一组内的元素保持原本的顺序
+// 例如按照客户 ID 对订单分组
+// src 为 nil 的时候返回一个非 nil 的空 map，调用者可以直接遍历
+func GroupBy[T any, K comparable](src []T, keyFunc func(T) K) map[K][]T {
+	// 预估每一组平均有 4 个元素，避免为大量重复 key 的场景分配过大的 map
+	res := make(map[K][]T, len(src)/4)
+	for _, v := range src {
+		k := keyFunc(v)
+		res[k] = append(res[k], v)
+	}
+	return res
+}
+
+// GroupByTimeBucket 按照时间桶对元素进行分组
+// tsFunc 返回元素的时间，该时间会先转为 UTC，然后按照 bucket 向下取整，取整后的时间作为分组的 key
+// 例如 bucket 为 time.Hour 的时候，同一个小时内的元素会被分在同一组
+// 每一组内的元素保持原本的顺序；bucket <= 0 时不取整
+func GroupByTimeBucket[T any](src []T, tsFunc func(T) time.Time, bucket time.Duration) map[time.Time][]T {
+	res := make(map[time.Time][]T)
+	for _, v := range src {
+		key := tsFunc(v).UTC().Truncate(bucket)
+		res[key] = append(res[key], v)
+	}
+	return res
+}
+
+// Pivot 通过 kv 从每一个元素中取出 key 和 value，并将 value 按照 key 分组
+// 例如将商品-标签的扁平查询结果整理为每个商品对应的标签列表
+// 每一组内的 value 保持原本的顺序
+func Pivot[T any, K comparable, V any](src []T, kv func(T) (K, V)) map[K][]V {
+	res := make(map[K][]V)
+	for _, t := range src {
+		k, v := kv(t)
+		res[k] = append(res[k], v)
+	}
+	return res
+}
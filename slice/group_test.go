@@ -0,0 +1,139 @@
+package slice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type groupEvent struct {
+	ID int
+	At time.Time
+}
+
+func TestGroupBy(t *testing.T) {
+	type order struct {
+		ID         int
+		CustomerID int
+	}
+	testCases := []struct {
+		name string
+		src  []order
+		want map[int][]order
+	}{
+		{
+			name: "nil",
+			want: map[int][]order{},
+		},
+		{
+			name: "normal",
+			src: []order{
+				{ID: 1, CustomerID: 10},
+				{ID: 2, CustomerID: 20},
+				{ID: 3, CustomerID: 10},
+				{ID: 4, CustomerID: 30},
+				{ID: 5, CustomerID: 10},
+			},
+			want: map[int][]order{
+				10: {{ID: 1, CustomerID: 10}, {ID: 3, CustomerID: 10}, {ID: 5, CustomerID: 10}},
+				20: {{ID: 2, CustomerID: 20}},
+				30: {{ID: 4, CustomerID: 30}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := GroupBy(tc.src, func(o order) int {
+				return o.CustomerID
+			})
+			assert.NotNil(t, res)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestGroupByTimeBucket(t *testing.T) {
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	shanghai := time.FixedZone("Asia/Shanghai", 8*3600)
+	events := []groupEvent{
+		{ID: 1, At: base.Add(5 * time.Minute)},
+		{ID: 2, At: base.Add(70 * time.Minute)},
+		{ID: 3, At: base.Add(59 * time.Minute)},
+		// 其他时区的时间会按照 UTC 分组
+		{ID: 4, At: base.Add(90 * time.Minute).In(shanghai)},
+	}
+	testCases := []struct {
+		name   string
+		src    []groupEvent
+		bucket time.Duration
+		want   map[time.Time][]groupEvent
+	}{
+		{
+			name:   "nil",
+			bucket: time.Hour,
+			want:   map[time.Time][]groupEvent{},
+		},
+		{
+			name:   "hourly",
+			src:    events,
+			bucket: time.Hour,
+			want: map[time.Time][]groupEvent{
+				base:                {events[0], events[2]},
+				base.Add(time.Hour): {events[1], events[3]},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := GroupByTimeBucket(tc.src, func(e groupEvent) time.Time {
+				return e.At
+			}, tc.bucket)
+			assert.Equal(t, tc.want, res)
+			for k := range res {
+				assert.Equal(t, time.UTC, k.Location())
+			}
+		})
+	}
+}
+
+func TestPivot(t *testing.T) {
+	type productTag struct {
+		ProductID int
+		Tag       string
+	}
+	testCases := []struct {
+		name string
+		src  []productTag
+		want map[int][]string
+	}{
+		{
+			name: "nil",
+			want: map[int][]string{},
+		},
+		{
+			name: "multiple values under same key",
+			src: []productTag{
+				{ProductID: 1, Tag: "new"},
+				{ProductID: 2, Tag: "hot"},
+				{ProductID: 1, Tag: "sale"},
+				{ProductID: 1, Tag: "hot"},
+			},
+			want: map[int][]string{
+				1: {"new", "sale", "hot"},
+				2: {"hot"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Pivot(tc.src, func(pt productTag) (int, string) {
+				return pt.ProductID, pt.Tag
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
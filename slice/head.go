@@ -0,0 +1,11 @@
+package slice
+
+// HeadTail 返回第一个元素以及剩余的元素
+// src 为空的时候 ok 为 false
+// 注意：tail 是 src 的子切片，和 src 共享底层数组，修改 tail 会影响 src，反之亦然
+func HeadTail[T any](src []T) (head T, tail []T, ok bool) {
+	if len(src) == 0 {
+		return head, nil, false
+	}
+	return src[0], src[1:], true
+}
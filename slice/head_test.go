@@ -0,0 +1,70 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadTail(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		wantHead int
+		wantTail []int
+		wantOk   bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "empty",
+			src:  []int{},
+		},
+		{
+			name:     "single",
+			src:      []int{1},
+			wantHead: 1,
+			wantTail: []int{},
+			wantOk:   true,
+		},
+		{
+			name:     "values",
+			src:      []int{1, 2, 3},
+			wantHead: 1,
+			wantTail: []int{2, 3},
+			wantOk:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			head, tail, ok := HeadTail(tc.src)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantHead, head)
+			assert.Equal(t, tc.wantTail, tail)
+		})
+	}
+}
+
+func TestHeadTail_Alias(t *testing.T) {
+	src := []int{1, 2, 3}
+	_, tail, _ := HeadTail(src)
+	src[1] = 100
+	assert.Equal(t, 100, tail[0])
+	tail[1] = 200
+	assert.Equal(t, []int{1, 100, 200}, src)
+}
+
+// 递归处理的示例
+func sumByHeadTail(src []int) int {
+	head, tail, ok := HeadTail(src)
+	if !ok {
+		return 0
+	}
+	return head + sumByHeadTail(tail)
+}
+
+func TestHeadTail_Recursive(t *testing.T) {
+	assert.Equal(t, 10, sumByHeadTail([]int{1, 2, 3, 4}))
+}
@@ -0,0 +1,76 @@
+package slice
+
+import (
+	"github.com/lhh-gh/ekit"
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// Index 返回第一个等于 target 的元素的下标，找不到返回 -1
+// 语义和标准库的 slices.Index 一致，可以直接替换
+func Index[T comparable](src []T, target T) int {
+	return IndexFunc(src, func(t T) bool {
+		return t == target
+	})
+}
+
+// LastIndex 返回最后一个等于 target 的元素的下标，找不到返回 -1
+// 从末尾开始查找，找到第一个匹配的元素就返回
+func LastIndex[T comparable](src []T, target T) int {
+	for i := len(src) - 1; i >= 0; i-- {
+		if src[i] == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexFunc 返回第一个满足 match 的元素的下标，找不到返回 -1
+func IndexFunc[T any](src []T, match func(T) bool) int {
+	for i, v := range src {
+		if match(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexBy 返回第一个 keyFunc(元素) == target 的元素的下标，找不到返回 -1
+// 适用于按照 ID 之类的字段查找结构体的场景
+func IndexBy[T any, K comparable](src []T, keyFunc func(T) K, target K) int {
+	for i, v := range src {
+		if keyFunc(v) == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexOfMaxBy 返回 keyFunc(元素) 最大的元素的下标，有多个最大值的时候返回第一个
+// src 为空时返回 errs.ErrEmptySlice
+func IndexOfMaxBy[T any, K ekit.Ordered](src []T, keyFunc func(T) K) (int, error) {
+	return indexOfExtremeBy(src, keyFunc, func(cur, best K) bool {
+		return cur > best
+	})
+}
+
+// IndexOfMinBy 返回 keyFunc(元素) 最小的元素的下标，有多个最小值的时候返回第一个
+// src 为空时返回 errs.ErrEmptySlice
+func IndexOfMinBy[T any, K ekit.Ordered](src []T, keyFunc func(T) K) (int, error) {
+	return indexOfExtremeBy(src, keyFunc, func(cur, best K) bool {
+		return cur < best
+	})
+}
+
+// indexOfExtremeBy better 返回 true 的时候，cur 会替换当前的最优值
+func indexOfExtremeBy[T any, K ekit.Ordered](src []T, keyFunc func(T) K, better func(cur, best K) bool) (int, error) {
+	if len(src) == 0 {
+		return -1, errs.ErrEmptySlice
+	}
+	idx, best := 0, keyFunc(src[0])
+	for i := 1; i < len(src); i++ {
+		if cur := keyFunc(src[i]); better(cur, best) {
+			idx, best = i, cur
+		}
+	}
+	return idx, nil
+}
@@ -0,0 +1,190 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+type indexUser struct {
+	ID   int
+	Name string
+}
+
+func TestIndex(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		target   int
+		want     int
+		wantLast int
+	}{
+		{
+			name:     "nil",
+			target:   1,
+			want:     -1,
+			wantLast: -1,
+		},
+		{
+			name:     "empty",
+			src:      []int{},
+			target:   1,
+			want:     -1,
+			wantLast: -1,
+		},
+		{
+			name:     "single match",
+			src:      []int{1, 2, 3},
+			target:   2,
+			want:     1,
+			wantLast: 1,
+		},
+		{
+			name:     "multiple matches",
+			src:      []int{2, 1, 2, 3, 2, 4},
+			target:   2,
+			want:     0,
+			wantLast: 4,
+		},
+		{
+			name:     "not found",
+			src:      []int{1, 2, 3},
+			target:   4,
+			want:     -1,
+			wantLast: -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Index(tc.src, tc.target))
+			assert.Equal(t, tc.wantLast, LastIndex(tc.src, tc.target))
+		})
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	users := []indexUser{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}, {ID: 2, Name: "Spike"}}
+	testCases := []struct {
+		name string
+		src  []indexUser
+		id   int
+		want int
+	}{
+		{
+			name: "nil",
+			id:   1,
+			want: -1,
+		},
+		{
+			name: "found first",
+			src:  users,
+			id:   2,
+			want: 1,
+		},
+		{
+			name: "not found",
+			src:  users,
+			id:   3,
+			want: -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := IndexFunc(tc.src, func(u indexUser) bool {
+				return u.ID == tc.id
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	users := []indexUser{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}, {ID: 2, Name: "Spike"}}
+	testCases := []struct {
+		name   string
+		src    []indexUser
+		target int
+		want   int
+	}{
+		{
+			name:   "nil",
+			target: 1,
+			want:   -1,
+		},
+		{
+			name:   "found",
+			src:    users,
+			target: 1,
+			want:   0,
+		},
+		{
+			name:   "first match",
+			src:    users,
+			target: 2,
+			want:   1,
+		},
+		{
+			name:   "not found",
+			src:    users,
+			target: 3,
+			want:   -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := IndexBy(tc.src, func(u indexUser) int { return u.ID }, tc.target)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestIndexOfMaxBy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []indexUser
+		wantMax int
+		wantMin int
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantMax: -1,
+			wantMin: -1,
+			wantErr: errs.ErrEmptySlice,
+		},
+		{
+			name:    "single",
+			src:     []indexUser{{ID: 1}},
+			wantMax: 0,
+			wantMin: 0,
+		},
+		{
+			name:    "values",
+			src:     []indexUser{{ID: 3}, {ID: 1}, {ID: 5}, {ID: 2}},
+			wantMax: 2,
+			wantMin: 1,
+		},
+		{
+			name:    "ties return first",
+			src:     []indexUser{{ID: 1}, {ID: 5}, {ID: 1}, {ID: 5}},
+			wantMax: 1,
+			wantMin: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keyFunc := func(u indexUser) int { return u.ID }
+			idx, err := IndexOfMaxBy(tc.src, keyFunc)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMax, idx)
+			idx, err = IndexOfMinBy(tc.src, keyFunc)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMin, idx)
+		})
+	}
+}
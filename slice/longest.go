@@ -0,0 +1,31 @@
+package slice
+
+// Longest 返回长度最长的切片，长度相同的时候返回第一个
+// nil 切片的长度视为 0；没有传入任何切片时返回 nil
+func Longest[T any](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+	res := slices[0]
+	for _, s := range slices[1:] {
+		if len(s) > len(res) {
+			res = s
+		}
+	}
+	return res
+}
+
+// Shortest 返回长度最短的切片，长度相同的时候返回第一个
+// nil 切片的长度视为 0；没有传入任何切片时返回 nil
+func Shortest[T any](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+	res := slices[0]
+	for _, s := range slices[1:] {
+		if len(s) < len(res) {
+			res = s
+		}
+	}
+	return res
+}
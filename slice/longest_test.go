@@ -0,0 +1,51 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLongest(t *testing.T) {
+	testCases := []struct {
+		name         string
+		slices       [][]int
+		wantLongest  []int
+		wantShortest []int
+	}{
+		{
+			name: "no input",
+		},
+		{
+			name:         "single",
+			slices:       [][]int{{1}},
+			wantLongest:  []int{1},
+			wantShortest: []int{1},
+		},
+		{
+			name:         "values",
+			slices:       [][]int{{1, 2}, {1, 2, 3}, {1}},
+			wantLongest:  []int{1, 2, 3},
+			wantShortest: []int{1},
+		},
+		{
+			name:         "ties return first",
+			slices:       [][]int{{1}, {2, 3}, {4}, {5, 6}},
+			wantLongest:  []int{2, 3},
+			wantShortest: []int{1},
+		},
+		{
+			name:         "nil as empty",
+			slices:       [][]int{{1}, nil, {}},
+			wantLongest:  []int{1},
+			wantShortest: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantLongest, Longest(tc.slices...))
+			assert.Equal(t, tc.wantShortest, Shortest(tc.slices...))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package slice
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lhh-gh/ekit"
+)
+
+// MapKeysEqual 判断两个 map 的 key 集合是否完全一致
+// 只比较 key，不关心 value 以及 value 的类型
+// 例如可以用来校验解密后的配置 map 是否包含且只包含预期的字段
+func MapKeysEqual[K comparable, V1 any, V2 any](a map[K]V1, b map[K]V2) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// SortKeysByValue 返回 m 的所有 key，按照 key 对应的 value 排序
+// desc 为 true 时降序，否则升序
+// value 相同的 key 按照 fmt.Sprint(key) 的字典序升序排列，保证结果稳定
+// 浮点数的 NaN 无论升序还是降序都排在最后
+func SortKeysByValue[K comparable, V ekit.Ordered](m map[K]V, desc bool) []K {
+	type entry struct {
+		key K
+		val V
+		// str 每个 key 只调用一次 fmt.Sprint，避免在比较的时候反复分配内存
+		str string
+	}
+	entries := make([]entry, 0, len(m))
+	for k, v := range m {
+		entries = append(entries, entry{key: k, val: v, str: fmt.Sprint(k)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		vi, vj := entries[i].val, entries[j].val
+		// NaN 和任何值比较都是 false，单独处理以保证排序的一致性
+		if nanI, nanJ := isNaN(vi), isNaN(vj); nanI || nanJ {
+			if nanI != nanJ {
+				return nanJ
+			}
+		} else if vi != vj {
+			if desc {
+				return vi > vj
+			}
+			return vi < vj
+		}
+		return entries[i].str < entries[j].str
+	})
+	keys := make([]K, len(entries))
+	for i, e := range entries {
+		keys[i] = e.key
+	}
+	return keys
+}
+
+// isNaN 判断 v 是否为 NaN，只有 NaN 不等于自身
+func isNaN[T ekit.Ordered](v T) bool {
+	return v != v
+}
+
+// MergeMaps 将多个 map 合并成一个新的 map
+// key 冲突的时候，后面的 map 中的值会覆盖前面的；nil map 会被忽略
+// 不会修改传入的 map
+func MergeMaps[K comparable, V any](maps ...map[K]V) map[K]V {
+	return MergeMapsFunc(func(_, cur V) V {
+		return cur
+	}, maps...)
+}
+
+// MergeMapsFunc 将多个 map 合并成一个新的 map
+// key 冲突的时候，使用 merge 计算最终的值，merge 的第一个参数是已经合并的值，第二个参数是当前 map 中的值
+// nil map 会被忽略，不会修改传入的 map
+func MergeMapsFunc[K comparable, V any](merge func(prev, cur V) V, maps ...map[K]V) map[K]V {
+	size := 0
+	for _, m := range maps {
+		size = max(size, len(m))
+	}
+	res := make(map[K]V, size)
+	for _, m := range maps {
+		for k, v := range m {
+			if prev, ok := res[k]; ok {
+				v = merge(prev, v)
+			}
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// MapEqual 判断两个 map 是否包含完全相同的键值对
+// nil map 和空 map 视为相等
+func MapEqual[K comparable, V comparable](a, b map[K]V) bool {
+	return MapEqualFunc(a, b, func(x, y V) bool {
+		return x == y
+	})
+}
+
+// MapEqualFunc 判断两个 map 是否包含相同的 key，并且每个 key 对应的值满足 equal
+// 适用于 V 无法直接比较的场景；nil map 和空 map 视为相等
+func MapEqualFunc[K comparable, V1 any, V2 any](a map[K]V1, b map[K]V2, equal func(x V1, y V2) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		vb, ok := b[k]
+		if !ok || !equal(va, vb) {
+			return false
+		}
+	}
+	return true
+}
+
+// ToMap 以 keyFunc 返回的值为 key，将 src 转化为 map，常用于构造查找表
+// 注意：多个元素的 key 相同时，后面的元素会覆盖前面的元素，即最后一个元素生效
+// src 为 nil 的时候返回一个非 nil 的空 map
+func ToMap[T any, K comparable](src []T, keyFunc func(T) K) map[K]T {
+	return ToMapV(src, func(t T) (K, T) {
+		return keyFunc(t), t
+	})
+}
+
+// ToMapV 通过 kvFunc 从每一个元素中取出 key 和 value，构造 map
+// 注意：多个元素的 key 相同时，最后一个元素的 value 生效
+// src 为 nil 的时候返回一个非 nil 的空 map
+func ToMapV[T any, K comparable, V any](src []T, kvFunc func(T) (K, V)) map[K]V {
+	res := make(map[K]V, len(src))
+	for _, t := range src {
+		k, v := kvFunc(t)
+		res[k] = v
+	}
+	return res
+}
+
+// Keys 返回 m 的所有 key
+// map 的遍历顺序是随机的，因此结果的顺序也是随机的，需要稳定的顺序时使用 SortedKeys
+func Keys[K comparable, V any](m map[K]V) []K {
+	res := make([]K, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	return res
+}
+
+// Values 返回 m 的所有 value，结果的顺序是随机的
+func Values[K comparable, V any](m map[K]V) []V {
+	res := make([]V, 0, len(m))
+	for _, v := range m {
+		res = append(res, v)
+	}
+	return res
+}
+
+// SortedKeys 返回 m 的所有 key，按照升序排列
+// 适合输出配置之类需要稳定顺序的场景
+func SortedKeys[K ekit.Ordered, V any](m map[K]V) []K {
+	res := Keys(m)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return res
+}
@@ -0,0 +1,282 @@
+package slice
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapKeysEqual(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    map[string]int
+		b    map[string]string
+		want bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name: "nil and empty",
+			a:    map[string]int{},
+			want: true,
+		},
+		{
+			name: "same keys different value types",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]string{"a": "x", "b": "y"},
+			want: true,
+		},
+		{
+			name: "missing key",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]string{"a": "x"},
+			want: false,
+		},
+		{
+			name: "same length different keys",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]string{"a": "x", "c": "y"},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MapKeysEqual(tc.a, tc.b))
+		})
+	}
+}
+
+func TestSortKeysByValue(t *testing.T) {
+	freq := map[string]int{"apple": 3, "banana": 1, "cherry": 5, "date": 3}
+	testCases := []struct {
+		name string
+		m    map[string]int
+		desc bool
+		want []string
+	}{
+		{
+			name: "nil",
+			want: []string{},
+		},
+		{
+			name: "asc",
+			m:    freq,
+			want: []string{"banana", "apple", "date", "cherry"},
+		},
+		{
+			name: "desc",
+			m:    freq,
+			desc: true,
+			want: []string{"cherry", "apple", "date", "banana"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SortKeysByValue(tc.m, tc.desc))
+		})
+	}
+}
+
+func TestSortKeysByValue_NaN(t *testing.T) {
+	m := map[string]float64{"a": math.NaN(), "b": 2, "c": 1, "d": math.NaN(), "e": 2}
+	assert.Equal(t, []string{"c", "b", "e", "a", "d"}, SortKeysByValue(m, false))
+	assert.Equal(t, []string{"b", "e", "c", "a", "d"}, SortKeysByValue(m, true))
+}
+
+func TestMergeMaps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		maps     []map[string]int
+		wantLast map[string]int
+		wantSum  map[string]int
+	}{
+		{
+			name:     "no input",
+			wantLast: map[string]int{},
+			wantSum:  map[string]int{},
+		},
+		{
+			name:     "nil maps",
+			maps:     []map[string]int{nil, {"a": 1}, nil},
+			wantLast: map[string]int{"a": 1},
+			wantSum:  map[string]int{"a": 1},
+		},
+		{
+			name: "collision",
+			maps: []map[string]int{
+				{"a": 1, "b": 2},
+				{"b": 3, "c": 4},
+				{"a": 5},
+			},
+			wantLast: map[string]int{"a": 5, "b": 3, "c": 4},
+			wantSum:  map[string]int{"a": 6, "b": 5, "c": 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantLast, MergeMaps(tc.maps...))
+			res := MergeMapsFunc(func(prev, cur int) int {
+				return prev + cur
+			}, tc.maps...)
+			assert.Equal(t, tc.wantSum, res)
+		})
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    map[string]int
+		b    map[string]int
+		want bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name: "nil and empty",
+			b:    map[string]int{},
+			want: true,
+		},
+		{
+			name: "equal",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"b": 2, "a": 1},
+			want: true,
+		},
+		{
+			name: "value mismatch",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"a": 1, "b": 3},
+			want: false,
+		},
+		{
+			name: "key mismatch",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"a": 1, "c": 2},
+			want: false,
+		},
+		{
+			name: "length mismatch",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"a": 1},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MapEqual(tc.a, tc.b))
+		})
+	}
+}
+
+func TestMapEqualFunc(t *testing.T) {
+	a := map[string][]int{"a": {1, 2}, "b": {3}}
+	equal := func(x, y []int) bool {
+		return len(x) == len(y) && IsPermutationOf(x, y)
+	}
+	assert.True(t, MapEqualFunc(a, map[string][]int{"a": {2, 1}, "b": {3}}, equal))
+	assert.False(t, MapEqualFunc(a, map[string][]int{"a": {1, 2}, "b": {4}}, equal))
+	assert.False(t, MapEqualFunc(a, map[string][]int{"a": {1, 2}, "c": {3}}, equal))
+	assert.True(t, MapEqualFunc(map[string][]int{}, nil, equal))
+}
+
+func ExampleMapKeysEqual() {
+	fmt.Println(MapKeysEqual(map[string]int{"a": 1}, map[string]bool{"a": true}))
+	fmt.Println(MapKeysEqual(map[string]int{"a": 1}, map[string]bool{"b": true}))
+	// Output:
+	// true
+	// false
+}
+
+func TestToMap(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	testCases := []struct {
+		name  string
+		src   []user
+		want  map[int]user
+		wantV map[int]string
+	}{
+		{
+			name:  "nil",
+			want:  map[int]user{},
+			wantV: map[int]string{},
+		},
+		{
+			name: "normal",
+			src:  []user{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}},
+			want: map[int]user{
+				1: {ID: 1, Name: "Tom"},
+				2: {ID: 2, Name: "Jerry"},
+			},
+			wantV: map[int]string{1: "Tom", 2: "Jerry"},
+		},
+		{
+			name: "duplicate key last wins",
+			src:  []user{{ID: 1, Name: "Tom"}, {ID: 2, Name: "Jerry"}, {ID: 1, Name: "Spike"}},
+			want: map[int]user{
+				1: {ID: 1, Name: "Spike"},
+				2: {ID: 2, Name: "Jerry"},
+			},
+			wantV: map[int]string{1: "Spike", 2: "Jerry"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ToMap(tc.src, func(u user) int {
+				return u.ID
+			})
+			assert.Equal(t, tc.want, res)
+			resV := ToMapV(tc.src, func(u user) (int, string) {
+				return u.ID, u.Name
+			})
+			assert.Equal(t, tc.wantV, resV)
+		})
+	}
+}
+
+func TestKeysValues(t *testing.T) {
+	testCases := []struct {
+		name       string
+		m          map[string]int
+		wantKeys   []string
+		wantValues []int
+	}{
+		{
+			name:       "nil",
+			wantKeys:   []string{},
+			wantValues: []int{},
+		},
+		{
+			name:       "normal",
+			m:          map[string]int{"b": 2, "a": 1, "c": 3},
+			wantKeys:   []string{"a", "b", "c"},
+			wantValues: []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys := Keys(tc.m)
+			assert.ElementsMatch(t, tc.wantKeys, keys)
+			assert.Equal(t, len(tc.m), cap(keys))
+			values := Values(tc.m)
+			assert.ElementsMatch(t, tc.wantValues, values)
+			assert.Equal(t, len(tc.m), cap(values))
+			assert.Equal(t, tc.wantKeys, SortedKeys(tc.m))
+		})
+	}
+}
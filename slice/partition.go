@@ -0,0 +1,66 @@
+package slice
+
+import "github.com/lhh-gh/ekit/internal/errs"
+
+// Partition 只遍历一次 src，将满足 match 的元素放入 matched，其余的放入 unmatched
+// 两个结果各自分配内存，修改其中一个不会影响另一个，也不会影响 src
+// 元素保持原本的顺序；src 为 nil 的时候返回两个 nil
+func Partition[T any](src []T, match func(T) bool) (matched, unmatched []T) {
+	if src == nil {
+		return nil, nil
+	}
+	matched = make([]T, 0, len(src)/2)
+	unmatched = make([]T, 0, len(src)/2)
+	for _, v := range src {
+		if match(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
+
+// PartitionN 按照 bucketFunc 返回的桶下标将元素分配到 n 个桶中
+// bucketFunc 返回的下标应该在 [0, n) 之间，
+// 小于 0 的会被放入第一个桶，大于等于 n 的会被放入最后一个桶
+// n <= 0 时返回 nil
+func PartitionN[T any](src []T, n int, bucketFunc func(idx int, t T) int) [][]T {
+	if n <= 0 {
+		return nil
+	}
+	res := make([][]T, n)
+	for i, v := range src {
+		b := bucketFunc(i, v)
+		if b < 0 {
+			b = 0
+		} else if b >= n {
+			b = n - 1
+		}
+		res[b] = append(res[b], v)
+	}
+	return res
+}
+
+// Distribute 将 src 轮流分配到 n 个部分中，即第 i 个元素被分到第 i%n 个部分
+// 和连续切分不同，相邻元素会被分到不同的部分，
+// 当相邻元素的处理成本相近时，这种方式能更均衡地分配负载
+// 每个部分内的元素保持原本的顺序；n <= 0 时返回错误
+func Distribute[T any](src []T, n int) ([][]T, error) {
+	if n <= 0 {
+		return nil, errs.NewErrInvalidSize(n)
+	}
+	res := make([][]T, n)
+	for i := range res {
+		// 前 len(src)%n 个部分会多分到一个元素
+		size := len(src) / n
+		if i < len(src)%n {
+			size++
+		}
+		res[i] = make([]T, 0, size)
+	}
+	for i, v := range src {
+		res[i%n] = append(res[i%n], v)
+	}
+	return res, nil
+}
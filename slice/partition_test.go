@@ -0,0 +1,190 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	testCases := []struct {
+		name          string
+		src           []int
+		wantMatched   []int
+		wantUnmatched []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:          "empty",
+			src:           []int{},
+			wantMatched:   []int{},
+			wantUnmatched: []int{},
+		},
+		{
+			name:          "normal",
+			src:           []int{1, 2, 3, 4, 5},
+			wantMatched:   []int{2, 4},
+			wantUnmatched: []int{1, 3, 5},
+		},
+		{
+			name:          "all matched",
+			src:           []int{2, 4},
+			wantMatched:   []int{2, 4},
+			wantUnmatched: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, unmatched := Partition(tc.src, func(v int) bool {
+				return v%2 == 0
+			})
+			assert.Equal(t, tc.wantMatched, matched)
+			assert.Equal(t, tc.wantUnmatched, unmatched)
+		})
+	}
+}
+
+func TestPartition_Independent(t *testing.T) {
+	src := []int{1, 2, 3, 4}
+	matched, unmatched := Partition(src, func(v int) bool {
+		return v%2 == 0
+	})
+	matched[0] = 20
+	matched = append(matched, 6, 8, 10)
+	unmatched[0] = 10
+	assert.Equal(t, []int{1, 2, 3, 4}, src)
+	assert.Equal(t, []int{10, 3}, unmatched)
+	assert.Equal(t, []int{20, 4, 6, 8, 10}, matched)
+}
+
+func BenchmarkPartition(b *testing.B) {
+	src := make([]int, 1024)
+	for i := range src {
+		src[i] = i
+	}
+	even := func(v int) bool { return v%2 == 0 }
+	b.Run("Partition", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = Partition(src, even)
+		}
+	})
+	b.Run("Filter twice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Filter(src, func(idx int, v int) bool { return even(v) })
+			_ = Filter(src, func(idx int, v int) bool { return !even(v) })
+		}
+	})
+}
+
+func TestPartitionN(t *testing.T) {
+	testCases := []struct {
+		name       string
+		src        []int
+		n          int
+		bucketFunc func(idx int, t int) int
+		want       [][]int
+	}{
+		{
+			name:       "n 0",
+			src:        []int{1, 2, 3},
+			n:          0,
+			bucketFunc: func(idx int, t int) int { return 0 },
+		},
+		{
+			name:       "nil",
+			n:          2,
+			bucketFunc: func(idx int, t int) int { return 0 },
+			want:       [][]int{nil, nil},
+		},
+		{
+			name:       "modulo",
+			src:        []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			n:          3,
+			bucketFunc: func(idx int, t int) int { return t % 3 },
+			want:       [][]int{{0, 3, 6, 9}, {1, 4, 7}, {2, 5, 8}},
+		},
+		{
+			name:       "by index",
+			src:        []int{10, 11, 12, 13},
+			n:          2,
+			bucketFunc: func(idx int, t int) int { return idx / 2 },
+			want:       [][]int{{10, 11}, {12, 13}},
+		},
+		{
+			name: "out of range",
+			src:  []int{1, 2, 3, 4},
+			n:    2,
+			bucketFunc: func(idx int, t int) int {
+				if t%2 == 0 {
+					return -1
+				}
+				return 5
+			},
+			want: [][]int{{2, 4}, {1, 3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := PartitionN(tc.src, tc.n, tc.bucketFunc)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestDistribute(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		n       int
+		want    [][]int
+		wantErr error
+	}{
+		{
+			name:    "n 0",
+			src:     []int{1, 2},
+			n:       0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:    "n negative",
+			src:     []int{1, 2},
+			n:       -1,
+			wantErr: errs.NewErrInvalidSize(-1),
+		},
+		{
+			name: "nil",
+			n:    2,
+			want: [][]int{{}, {}},
+		},
+		{
+			name: "round robin",
+			src:  []int{0, 1, 2, 3, 4, 5, 6},
+			n:    3,
+			want: [][]int{{0, 3, 6}, {1, 4}, {2, 5}},
+		},
+		{
+			name: "n larger than len",
+			src:  []int{0, 1},
+			n:    3,
+			want: [][]int{{0}, {1}, {}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Distribute(tc.src, tc.n)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package slice
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/big"
+)
+
+// RandSource 随机数来源，所有带有随机性的方法都通过它来获取随机数
+// *rand.Rand 本身就实现了该接口，可以直接传入；
+// 需要密码学安全的随机数时可以使用 CryptoRandSource
+// 测试的时候也可以传入自定义的实现来得到确定的结果
+type RandSource interface {
+	// Intn 返回 [0, n) 之间的随机整数，n <= 0 时 panic
+	Intn(n int) int
+	// Float64 返回 [0.0, 1.0) 之间的随机浮点数
+	Float64() float64
+}
+
+// CryptoRandSource 基于 crypto/rand 的 RandSource 实现
+// 性能远低于 math/rand，只应该在对随机性有安全要求的场景下使用
+type CryptoRandSource struct{}
+
+// Intn 返回 [0, n) 之间的随机整数，n <= 0 时 panic
+func (CryptoRandSource) Intn(n int) int {
+	if n <= 0 {
+		panic("ekit: CryptoRandSource.Intn 的参数必须大于 0")
+	}
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(v.Int64())
+}
+
+// Float64 返回 [0.0, 1.0) 之间的随机浮点数
+func (CryptoRandSource) Float64() float64 {
+	var buf [8]byte
+	if _, err := crand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	// 取 53 位，保证结果均匀分布在 [0.0, 1.0)
+	return float64(binary.BigEndian.Uint64(buf[:])>>11) / (1 << 53)
+}
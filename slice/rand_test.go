@@ -0,0 +1,42 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCryptoRandSource(t *testing.T) {
+	var r RandSource = CryptoRandSource{}
+	for i := 0; i < 1000; i++ {
+		n := r.Intn(10)
+		assert.True(t, n >= 0 && n < 10)
+		f := r.Float64()
+		assert.True(t, f >= 0 && f < 1)
+	}
+	assert.Panics(t, func() {
+		r.Intn(0)
+	})
+}
+
+// 确保 *rand.Rand 实现了 RandSource
+var _ RandSource = (*rand.Rand)(nil)
+
+// fakeRandSource 按顺序返回预先设置好的值，用于得到确定的测试结果
+type fakeRandSource struct {
+	ints   []int
+	floats []float64
+}
+
+func (f *fakeRandSource) Intn(n int) int {
+	v := f.ints[0] % n
+	f.ints = f.ints[1:]
+	return v
+}
+
+func (f *fakeRandSource) Float64() float64 {
+	v := f.floats[0]
+	f.floats = f.floats[1:]
+	return v
+}
@@ -0,0 +1,72 @@
+package slice
+
+import (
+	"math"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// Fill 返回一个包含 count 个 value 的切片，适合用来构造测试数据或者默认值
+// 和 Expand、Cycle 一样，count <= 0 时返回空切片
+func Fill[T any](value T, count int) []T {
+	if count <= 0 {
+		return []T{}
+	}
+	res := make([]T, count)
+	for i := range res {
+		res[i] = value
+	}
+	return res
+}
+
+// FillRange 将 src 中 [start, end) 区间内的元素原地替换为 value
+// 需要满足 0 <= start <= end <= len(src)，否则返回错误并且不修改 src
+func FillRange[T any](src []T, value T, start, end int) error {
+	if start < 0 || start > end || end > len(src) {
+		return errs.NewErrInvalidRange(len(src), start, end)
+	}
+	for i := start; i < end; i++ {
+		src[i] = value
+	}
+	return nil
+}
+
+// Repeat 将 pattern 整体重复 times 次
+// 例如 Repeat([a, b], 2) 得到 [a, b, a, b]；和 Expand 不同，Expand 是逐个元素重复
+// pattern 为空或者 times <= 0 时返回空切片；结果的长度超出 int 的范围时同样返回空切片
+func Repeat[T any](pattern []T, times int) []T {
+	if len(pattern) == 0 || times <= 0 || times > math.MaxInt/len(pattern) {
+		return []T{}
+	}
+	return Cycle(pattern, len(pattern)*times)
+}
+
+// Expand 将每一个元素连续重复 k 次
+// 例如 [a, b] 在 k = 2 时得到 [a, a, b, b]
+// k <= 0 时返回空切片
+func Expand[T any](src []T, k int) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	res := make([]T, 0, len(src)*k)
+	for _, v := range src {
+		for i := 0; i < k; i++ {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Cycle 按顺序循环重复 src 中的元素，直到结果恰好有 length 个元素
+// 例如 Cycle([a, b], 5) 得到 [a, b, a, b, a]
+// src 为空或者 length <= 0 时返回空切片
+func Cycle[T any](src []T, length int) []T {
+	if len(src) == 0 || length <= 0 {
+		return []T{}
+	}
+	res := make([]T, length)
+	for i := 0; i < length; i += len(src) {
+		copy(res[i:], src)
+	}
+	return res
+}
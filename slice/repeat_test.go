@@ -0,0 +1,237 @@
+package slice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFill(t *testing.T) {
+	testCases := []struct {
+		name  string
+		count int
+		want  []string
+	}{
+		{
+			name:  "negative",
+			count: -1,
+			want:  []string{},
+		},
+		{
+			name:  "zero",
+			count: 0,
+			want:  []string{},
+		},
+		{
+			name:  "normal",
+			count: 3,
+			want:  []string{"x", "x", "x"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Fill("x", tc.count))
+		})
+	}
+}
+
+func TestFillRange(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		start     int
+		end       int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "middle",
+			src:       []int{1, 2, 3, 4},
+			start:     1,
+			end:       3,
+			wantSlice: []int{1, 9, 9, 4},
+		},
+		{
+			name:      "all",
+			src:       []int{1, 2},
+			start:     0,
+			end:       2,
+			wantSlice: []int{9, 9},
+		},
+		{
+			name:      "empty range",
+			src:       []int{1, 2},
+			start:     1,
+			end:       1,
+			wantSlice: []int{1, 2},
+		},
+		{
+			name:      "end out of range",
+			src:       []int{1, 2},
+			start:     0,
+			end:       3,
+			wantSlice: []int{1, 2},
+			wantErr:   errs.NewErrInvalidRange(2, 0, 3),
+		},
+		{
+			name:      "start greater than end",
+			src:       []int{1, 2},
+			start:     2,
+			end:       1,
+			wantSlice: []int{1, 2},
+			wantErr:   errs.NewErrInvalidRange(2, 2, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := FillRange(tc.src, 9, tc.start, tc.end)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantSlice, tc.src)
+		})
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern []int
+		times   int
+		want    []int
+	}{
+		{
+			name:  "nil pattern",
+			times: 2,
+			want:  []int{},
+		},
+		{
+			name:    "negative",
+			pattern: []int{1, 2},
+			times:   -1,
+			want:    []int{},
+		},
+		{
+			name:    "length overflow",
+			pattern: []int{1, 2},
+			times:   math.MaxInt/2 + 1,
+			want:    []int{},
+		},
+		{
+			name:    "normal",
+			pattern: []int{1, 2},
+			times:   3,
+			want:    []int{1, 2, 1, 2, 1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Repeat(tc.pattern, tc.times))
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []string
+		k    int
+		want []string
+	}{
+		{
+			name: "nil",
+			k:    2,
+			want: []string{},
+		},
+		{
+			name: "k 0",
+			src:  []string{"a", "b"},
+			k:    0,
+			want: []string{},
+		},
+		{
+			name: "k negative",
+			src:  []string{"a", "b"},
+			k:    -1,
+			want: []string{},
+		},
+		{
+			name: "k 1",
+			src:  []string{"a", "b"},
+			k:    1,
+			want: []string{"a", "b"},
+		},
+		{
+			name: "k 3",
+			src:  []string{"a", "b"},
+			k:    3,
+			want: []string{"a", "a", "a", "b", "b", "b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Expand(tc.src, tc.k)
+			assert.Equal(t, tc.want, res)
+			if tc.k == 1 && len(res) > 0 {
+				// k = 1 返回的是副本
+				res[0] = "z"
+				assert.Equal(t, "a", tc.src[0])
+			}
+		})
+	}
+}
+
+func TestCycle(t *testing.T) {
+	testCases := []struct {
+		name   string
+		src    []string
+		length int
+		want   []string
+	}{
+		{
+			name:   "nil",
+			length: 3,
+			want:   []string{},
+		},
+		{
+			name:   "length 0",
+			src:    []string{"a"},
+			length: 0,
+			want:   []string{},
+		},
+		{
+			name:   "length negative",
+			src:    []string{"a"},
+			length: -1,
+			want:   []string{},
+		},
+		{
+			name:   "truncate mid cycle",
+			src:    []string{"a", "b"},
+			length: 5,
+			want:   []string{"a", "b", "a", "b", "a"},
+		},
+		{
+			name:   "shorter than src",
+			src:    []string{"a", "b", "c"},
+			length: 2,
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "full cycles",
+			src:    []string{"a", "b"},
+			length: 4,
+			want:   []string{"a", "b", "a", "b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Cycle(tc.src, tc.length))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package slice
+
+// Reservoir 蓄水池抽样
+// 从一个长度未知、甚至无法全部放进内存的数据流中，等概率地抽取 k 个样本
+type Reservoir[T any] struct {
+	k       int
+	r       RandSource
+	samples []T
+	// seen 已经 Offer 过的元素个数
+	seen int
+}
+
+// NewReservoir 创建一个样本大小为 k 的蓄水池
+// r 是随机数来源，测试的时候可以传入固定种子的 *rand.Rand 来得到可复现的结果
+func NewReservoir[T any](k int, r RandSource) *Reservoir[T] {
+	return &Reservoir[T]{
+		k:       k,
+		r:       r,
+		samples: make([]T, 0, max(k, 0)),
+	}
+}
+
+// Offer 向蓄水池提供一个元素
+// 前 k 个元素会被直接放入蓄水池；
+// 之后第 n 个元素以 k/n 的概率替换蓄水池中随机的一个元素
+func (r *Reservoir[T]) Offer(t T) {
+	if r.k <= 0 {
+		return
+	}
+	r.seen++
+	if len(r.samples) < r.k {
+		r.samples = append(r.samples, t)
+		return
+	}
+	if j := r.r.Intn(r.seen); j < r.k {
+		r.samples[j] = t
+	}
+}
+
+// Sample 返回当前的样本
+// 提供的元素不足 k 个时，返回全部元素；返回的切片是一个副本
+func (r *Reservoir[T]) Sample() []T {
+	res := make([]T, len(r.samples))
+	copy(res, r.samples)
+	return res
+}
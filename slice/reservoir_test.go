@@ -0,0 +1,99 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservoir(t *testing.T) {
+	testCases := []struct {
+		name     string
+		k        int
+		offers   int
+		wantSize int
+	}{
+		{
+			name:     "k 0",
+			k:        0,
+			offers:   10,
+			wantSize: 0,
+		},
+		{
+			name:     "fewer offers than k",
+			k:        5,
+			offers:   3,
+			wantSize: 3,
+		},
+		{
+			name:     "many offers",
+			k:        5,
+			offers:   10000,
+			wantSize: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sample := func() []int {
+				r := NewReservoir[int](tc.k, rand.New(rand.NewSource(42)))
+				for i := 0; i < tc.offers; i++ {
+					r.Offer(i)
+				}
+				return r.Sample()
+			}
+			res := sample()
+			assert.Equal(t, tc.wantSize, len(res))
+			// 相同的种子得到相同的结果
+			assert.Equal(t, res, sample())
+			seen := make(map[int]struct{}, len(res))
+			for _, v := range res {
+				assert.True(t, v >= 0 && v < tc.offers)
+				seen[v] = struct{}{}
+			}
+			// 不会重复抽到同一个元素
+			assert.Equal(t, len(res), len(seen))
+		})
+	}
+}
+
+func TestReservoir_Uniform(t *testing.T) {
+	// 每个元素被抽中的概率应该接近 k/n
+	const (
+		k      = 2
+		n      = 10
+		rounds = 20000
+	)
+	r := rand.New(rand.NewSource(7))
+	counts := make([]int, n)
+	for i := 0; i < rounds; i++ {
+		res := NewReservoir[int](k, r)
+		for j := 0; j < n; j++ {
+			res.Offer(j)
+		}
+		for _, v := range res.Sample() {
+			counts[v]++
+		}
+	}
+	want := float64(rounds * k / n)
+	for _, cnt := range counts {
+		assert.InDelta(t, want, float64(cnt), want*0.1)
+	}
+}
+
+func TestReservoir_RandSource(t *testing.T) {
+	// 第 3、4、5 个元素分别替换下标 1、不替换、替换下标 0
+	src := &fakeRandSource{ints: []int{1, 3, 0}}
+	r := NewReservoir[string](2, src)
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		r.Offer(v)
+	}
+	assert.Equal(t, []string{"e", "c"}, r.Sample())
+
+	r = NewReservoir[string](2, CryptoRandSource{})
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		r.Offer(v)
+	}
+	assert.Equal(t, 2, len(r.Sample()))
+}
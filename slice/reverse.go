@@ -0,0 +1,22 @@
+package slice
+
+// Reverse 返回一个元素顺序和 src 相反的新切片，不会修改 src
+func Reverse[T any](src []T) []T {
+	if src == nil {
+		return nil
+	}
+	res := make([]T, len(src))
+	for i, v := range src {
+		res[len(src)-1-i] = v
+	}
+	return res
+}
+
+// ReverseSelf 原地翻转 src，并返回 src 方便链式调用
+// 不会分配新的内存，但是调用者持有的 src 也会被修改
+func ReverseSelf[T any](src []T) []T {
+	for i, j := 0, len(src)-1; i < j; i, j = i+1, j-1 {
+		src[i], src[j] = src[j], src[i]
+	}
+	return src
+}
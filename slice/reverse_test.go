@@ -0,0 +1,99 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "single",
+			src:  []int{1},
+			want: []int{1},
+		},
+		{
+			name: "odd",
+			src:  []int{1, 2, 3},
+			want: []int{3, 2, 1},
+		},
+		{
+			name: "even",
+			src:  []int{1, 2, 3, 4},
+			want: []int{4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := append([]int(nil), tc.src...)
+			res := Reverse(tc.src)
+			assert.Equal(t, tc.want, res)
+			// 不能修改原切片
+			assert.Equal(t, src, tc.src)
+		})
+	}
+}
+
+func TestReverseSelf(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "single",
+			src:  []int{1},
+			want: []int{1},
+		},
+		{
+			name: "odd",
+			src:  []int{1, 2, 3},
+			want: []int{3, 2, 1},
+		},
+		{
+			name: "even",
+			src:  []int{1, 2, 3, 4},
+			want: []int{4, 3, 2, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ReverseSelf(tc.src)
+			assert.Equal(t, tc.want, res)
+			// 原地翻转
+			assert.Equal(t, tc.want, tc.src)
+		})
+	}
+}
+
+func BenchmarkReverse(b *testing.B) {
+	src := make([]int, 1024)
+	for i := range src {
+		src[i] = i
+	}
+	b.Run("Reverse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Reverse(src)
+		}
+	})
+	b.Run("ReverseSelf", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ReverseSelf(src)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package slice
+
+// Rotate 返回循环移动之后的新切片，不会修改 src
+// steps 为正数时向左移动，为负数时向右移动，
+// 例如 Rotate([]int{1, 2, 3, 4}, 1) 的结果是 [2, 3, 4, 1]
+// steps 会对 len(src) 取模，因此很大的 steps 也能得到正确的结果
+func Rotate[T any](src []T, steps int) []T {
+	if src == nil {
+		return nil
+	}
+	res := make([]T, len(src))
+	if len(src) == 0 {
+		return res
+	}
+	k := normalizeSteps(steps, len(src))
+	n := copy(res, src[k:])
+	copy(res[n:], src[:k])
+	return res
+}
+
+// RotateSelf 原地循环移动 src，并返回 src 方便链式调用，steps 的含义和 Rotate 一致
+// 通过三次翻转实现，不会分配新的内存
+func RotateSelf[T any](src []T, steps int) []T {
+	if len(src) == 0 {
+		return src
+	}
+	k := normalizeSteps(steps, len(src))
+	if k == 0 {
+		return src
+	}
+	ReverseSelf(src[:k])
+	ReverseSelf(src[k:])
+	return ReverseSelf(src)
+}
+
+// normalizeSteps 将 steps 转化为 [0, length) 之间的向左移动的步数
+func normalizeSteps(steps, length int) int {
+	k := steps % length
+	if k < 0 {
+		k += length
+	}
+	return k
+}
@@ -0,0 +1,77 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		src   []int
+		steps int
+		want  []int
+	}{
+		{
+			name:  "nil",
+			steps: 1,
+		},
+		{
+			name:  "empty",
+			src:   []int{},
+			steps: 1,
+			want:  []int{},
+		},
+		{
+			name:  "left",
+			src:   []int{1, 2, 3, 4},
+			steps: 1,
+			want:  []int{2, 3, 4, 1},
+		},
+		{
+			name:  "right",
+			src:   []int{1, 2, 3, 4},
+			steps: -1,
+			want:  []int{4, 1, 2, 3},
+		},
+		{
+			name:  "zero",
+			src:   []int{1, 2, 3, 4},
+			steps: 0,
+			want:  []int{1, 2, 3, 4},
+		},
+		{
+			name:  "multiple of length",
+			src:   []int{1, 2, 3, 4},
+			steps: 8,
+			want:  []int{1, 2, 3, 4},
+		},
+		{
+			name:  "larger than length",
+			src:   []int{1, 2, 3, 4},
+			steps: 6,
+			want:  []int{3, 4, 1, 2},
+		},
+		{
+			name:  "negative larger than length",
+			src:   []int{1, 2, 3, 4},
+			steps: -5,
+			want:  []int{4, 1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := append([]int(nil), tc.src...)
+			res := Rotate(tc.src, tc.steps)
+			assert.Equal(t, tc.want, res)
+			// 不能修改原切片
+			assert.Equal(t, src, append([]int(nil), tc.src...))
+
+			res = RotateSelf(tc.src, tc.steps)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, tc.want, tc.src)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package slice
+
+// IntersectSet 求 a 和 b 的交集，结果去重，并且按照元素在 a 中第一次出现的顺序排列
+// nil 被当作空集合处理
+func IntersectSet[T comparable](a, b []T) []T {
+	set := toSet(b)
+	res := make([]T, 0, min(len(a), len(b)))
+	for _, v := range a {
+		if _, ok := set[v]; ok {
+			res = append(res, v)
+			// 删除之后，a 中重复的元素不会再被加入结果
+			delete(set, v)
+		}
+	}
+	return res
+}
+
+// UnionSet 求 a 和 b 的并集，结果去重
+// 先按照顺序排列 a 中的元素，再按照顺序排列只出现在 b 中的元素
+func UnionSet[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	res := DeduplicateInto(a, seen)
+	return append(res, DeduplicateInto(b, seen)...)
+}
+
+// DiffSet 求 a 和 b 的差集，即只出现在 a 中不出现在 b 中的元素
+// 结果去重，并且按照元素在 a 中第一次出现的顺序排列
+func DiffSet[T comparable](a, b []T) []T {
+	return DeduplicateInto(a, toSet(b))
+}
+
+// SymmetricDiffSet 求 a 和 b 的对称差集，即只出现在其中一个切片中的元素
+// 结果去重，先按照顺序排列只出现在 a 中的元素，再按照顺序排列只出现在 b 中的元素
+func SymmetricDiffSet[T comparable](a, b []T) []T {
+	res := DiffSet(a, b)
+	return append(res, DiffSet(b, a)...)
+}
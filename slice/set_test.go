@@ -0,0 +1,78 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOperations(t *testing.T) {
+	testCases := []struct {
+		name              string
+		a                 []int
+		b                 []int
+		wantIntersect     []int
+		wantUnion         []int
+		wantDiff          []int
+		wantSymmetricDiff []int
+	}{
+		{
+			name:              "nil",
+			wantIntersect:     []int{},
+			wantUnion:         []int{},
+			wantDiff:          []int{},
+			wantSymmetricDiff: []int{},
+		},
+		{
+			name:              "a nil",
+			b:                 []int{1, 2},
+			wantIntersect:     []int{},
+			wantUnion:         []int{1, 2},
+			wantDiff:          []int{},
+			wantSymmetricDiff: []int{1, 2},
+		},
+		{
+			name:              "b nil",
+			a:                 []int{2, 1, 2},
+			wantIntersect:     []int{},
+			wantUnion:         []int{2, 1},
+			wantDiff:          []int{2, 1},
+			wantSymmetricDiff: []int{2, 1},
+		},
+		{
+			name:              "overlapping with duplicates",
+			a:                 []int{5, 1, 3, 1, 4},
+			b:                 []int{4, 2, 5, 2, 6},
+			wantIntersect:     []int{5, 4},
+			wantUnion:         []int{5, 1, 3, 4, 2, 6},
+			wantDiff:          []int{1, 3},
+			wantSymmetricDiff: []int{1, 3, 2, 6},
+		},
+		{
+			name:              "same",
+			a:                 []int{1, 2, 3},
+			b:                 []int{3, 2, 1},
+			wantIntersect:     []int{1, 2, 3},
+			wantUnion:         []int{1, 2, 3},
+			wantDiff:          []int{},
+			wantSymmetricDiff: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantIntersect, IntersectSet(tc.a, tc.b))
+			assert.Equal(t, tc.wantUnion, UnionSet(tc.a, tc.b))
+			assert.Equal(t, tc.wantDiff, DiffSet(tc.a, tc.b))
+			assert.Equal(t, tc.wantSymmetricDiff, SymmetricDiffSet(tc.a, tc.b))
+		})
+	}
+}
+
+func TestSetOperations_Strings(t *testing.T) {
+	// 对比新旧两份权限列表
+	oldPerms := []string{"read", "write", "admin"}
+	newPerms := []string{"read", "write", "audit"}
+	assert.Equal(t, []string{"admin", "audit"}, SymmetricDiffSet(oldPerms, newPerms))
+	assert.Equal(t, []string{"admin"}, DiffSet(oldPerms, newPerms))
+}
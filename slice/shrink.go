@@ -0,0 +1,14 @@
+package slice
+
+import "github.com/lhh-gh/ekit/internal/slice"
+
+// Shrink 在切片的容量远大于长度的时候缩容，返回缩容后的切片
+// 不需要缩容的时候原样返回 src，否则分配一个更小的底层数组并复制元素
+// 大量删除元素之后，例如调用 DeleteFunc、DeleteRange 之后，可以调用该方法回收内存
+// 具体的缩容策略：
+//   - 容量不超过 64 的不缩容
+//   - 容量超过 2048，并且容量至少是长度的 2 倍时，缩容到原容量的 62.5%
+//   - 其余情况下容量至少是长度的 4 倍时，缩容到原容量的一半
+func Shrink[T any](src []T) []T {
+	return slice.Shrink[T](src)
+}
@@ -0,0 +1,42 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShrink(t *testing.T) {
+	// Shrink 主要依赖于 internal/slice.Shrink 来保证正确性
+	testCases := []struct {
+		name      string
+		originCap int
+		length    int
+		expectCap int
+	}{
+		{
+			name:      "small cap",
+			originCap: 32,
+			length:    2,
+			expectCap: 32,
+		},
+		{
+			name:      "after bulk deletion",
+			originCap: 1000,
+			length:    10,
+			expectCap: 500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := make([]int, tc.length, tc.originCap)
+			for i := range src {
+				src[i] = i
+			}
+			res := Shrink(src)
+			assert.Equal(t, tc.expectCap, cap(res))
+			assert.Equal(t, src, res)
+		})
+	}
+}
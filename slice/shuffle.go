@@ -0,0 +1,30 @@
+package slice
+
+import "math/rand"
+
+// Shuffle 使用 Fisher–Yates 算法原地打乱 src，随机数从 r 中获取
+// 传入固定种子的 *rand.Rand 可以在测试中得到确定的结果
+// src 的长度小于 2 的时候不会调用 r
+func Shuffle[T any](src []T, r RandSource) {
+	for i := len(src) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		src[i], src[j] = src[j], src[i]
+	}
+}
+
+// ShuffleSelf 和 Shuffle 一样，只是使用 math/rand 包级别的随机数来源
+// 结果不可复现，需要确定的结果时应该使用 Shuffle
+func ShuffleSelf[T any](src []T) {
+	Shuffle(src, globalRandSource{})
+}
+
+// globalRandSource 使用 math/rand 包级别函数的 RandSource 实现
+type globalRandSource struct{}
+
+func (globalRandSource) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+func (globalRandSource) Float64() float64 {
+	return rand.Float64()
+}
@@ -0,0 +1,62 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "single",
+			src:  []int{1},
+		},
+		{
+			name: "normal",
+			src:  []int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := append([]int(nil), tc.src...)
+			b := append([]int(nil), tc.src...)
+			Shuffle(a, rand.New(rand.NewSource(42)))
+			Shuffle(b, rand.New(rand.NewSource(42)))
+			// 相同的种子得到相同的结果
+			assert.Equal(t, a, b)
+			assert.True(t, IsPermutationOf(tc.src, a))
+		})
+	}
+}
+
+func TestShuffle_Deterministic(t *testing.T) {
+	// i = 3, 2, 1 时依次交换 src[i] 和 src[j]
+	src := []int{1, 2, 3, 4}
+	Shuffle(src, &fakeRandSource{ints: []int{0, 0, 0}})
+	assert.Equal(t, []int{2, 3, 4, 1}, src)
+}
+
+func TestShuffle_NoRandForShortSlice(t *testing.T) {
+	// 没有任何预设的值，一旦调用就会 panic
+	r := &fakeRandSource{}
+	assert.NotPanics(t, func() {
+		Shuffle([]int{}, r)
+		Shuffle([]int{1}, r)
+	})
+}
+
+func TestShuffleSelf(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5}
+	res := append([]int(nil), src...)
+	ShuffleSelf(res)
+	assert.True(t, IsPermutationOf(src, res))
+}
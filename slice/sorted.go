@@ -0,0 +1,176 @@
+package slice
+
+import (
+	"sort"
+
+	"github.com/lhh-gh/ekit"
+)
+
+// SortedUnion 求两个升序切片的并集，结果升序且去重
+// 通过归并的方式实现，时间复杂度 O(n+m)，不需要额外的 map
+// 调用者需要保证 a 和 b 都是升序的
+func SortedUnion[T ekit.Ordered](a, b []T) []T {
+	res := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v T
+		switch {
+		case j >= len(b) || (i < len(a) && a[i] < b[j]):
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if len(res) == 0 || res[len(res)-1] != v {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// SortedIntersect 求两个升序切片的交集，结果升序且去重
+// 调用者需要保证 a 和 b 都是升序的
+func SortedIntersect[T ekit.Ordered](a, b []T) []T {
+	res := make([]T, 0, min(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			if len(res) == 0 || res[len(res)-1] != a[i] {
+				res = append(res, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	return res
+}
+
+// SortedDiff 求两个升序切片的差集，即在 a 中但是不在 b 中的元素，结果升序且去重
+// 调用者需要保证 a 和 b 都是升序的
+func SortedDiff[T ekit.Ordered](a, b []T) []T {
+	res := make([]T, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if len(res) == 0 || res[len(res)-1] != v {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// IndexOfSortedBy 在按照 keyFunc 升序排列的 src 中二分查找 key 等于 target 的元素
+// 找到时返回第一个匹配元素的下标和 true；
+// 找不到时返回 target 应该插入的位置和 false，插入后 src 依旧有序
+// 调用者需要保证 src 已经按照 keyFunc 升序排列
+func IndexOfSortedBy[T any, K ekit.Ordered](src []T, keyFunc func(T) K, target K) (int, bool) {
+	idx := sort.Search(len(src), func(i int) bool {
+		return keyFunc(src[i]) >= target
+	})
+	return idx, idx < len(src) && keyFunc(src[idx]) == target
+}
+
+// MergeSortedCounts 合并两个按照 Key 升序排列的 (值, 次数) 切片，Key 相同的次数相加
+// 结果依旧按照 Key 升序排列，并且每一个 Key 只出现一次
+// 适合合并多个分片上统计出来的直方图，时间复杂度 O(n+m)，不需要重新构造 map
+// 调用者需要保证 a 和 b 都是按照 Key 升序的
+func MergeSortedCounts[T ekit.Ordered](a, b []ekit.Pair[T, int]) []ekit.Pair[T, int] {
+	res := make([]ekit.Pair[T, int], 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var p ekit.Pair[T, int]
+		if j >= len(b) || (i < len(a) && a[i].Key <= b[j].Key) {
+			p = a[i]
+			i++
+		} else {
+			p = b[j]
+			j++
+		}
+		if len(res) > 0 && res[len(res)-1].Key == p.Key {
+			res[len(res)-1].Value += p.Value
+			continue
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// BinarySearch 在升序排列的 src 中二分查找 target
+// 找到时返回第一个等于 target 的元素的下标和 true；
+// 找不到时返回 target 应该插入的位置和 false，在该位置插入之后 src 依旧有序
+// 调用者需要保证 src 已经升序排列，否则结果没有意义
+func BinarySearch[T ekit.Ordered](src []T, target T) (int, bool) {
+	return BinarySearchFunc(src, target, compare[T])
+}
+
+// BinarySearchFunc 和 BinarySearch 一样，只是使用 cmp 来比较元素
+// cmp(a, b) 在 a < b 时返回负数，a == b 时返回 0，a > b 时返回正数
+// 调用者需要保证 src 已经按照 cmp 升序排列
+func BinarySearchFunc[T any](src []T, target T, cmp func(a, b T) int) (int, bool) {
+	idx := sort.Search(len(src), func(i int) bool {
+		return cmp(src[i], target) >= 0
+	})
+	return idx, idx < len(src) && cmp(src[idx], target) == 0
+}
+
+// SortedInsert 将 element 插入到升序排列的 src 中，插入之后依旧升序
+// 存在相等的元素时，element 会被插入到这些元素之后，即插入是稳定的
+// 可能会修改 src 的底层数组，调用者应该使用返回值
+// 调用者需要保证 src 已经升序排列
+func SortedInsert[T ekit.Ordered](src []T, element T) []T {
+	return SortedInsertFunc(src, element, compare[T])
+}
+
+// SortedInsertFunc 和 SortedInsert 一样，只是使用 cmp 来比较元素
+// 例如插入按照某个字段排序的结构体切片
+// 调用者需要保证 src 已经按照 cmp 升序排列
+func SortedInsertFunc[T any](src []T, element T, cmp func(a, b T) int) []T {
+	idx := sort.Search(len(src), func(i int) bool {
+		return cmp(src[i], element) > 0
+	})
+	// idx 总是在 [0, len(src)] 之间，不会返回错误
+	res, _ := Add(src, element, idx)
+	return res
+}
+
+// MergeSorted 将两个升序排列的切片合并为一个升序排列的新切片，时间复杂度 O(n+m)
+// 只分配一次 len(a)+len(b) 的内存，不会去重，所有的元素都会被保留
+// 合并是稳定的：相等的元素中，来自 a 的排在来自 b 的之前，各自内部保持原本的顺序
+// 调用者需要保证 a 和 b 都是升序的
+func MergeSorted[T ekit.Ordered](a, b []T) []T {
+	return MergeSortedFunc(a, b, compare[T])
+}
+
+// MergeSortedFunc 和 MergeSorted 一样，只是使用 cmp 来比较元素
+// 调用者需要保证 a 和 b 都已经按照 cmp 升序排列
+func MergeSortedFunc[T any](a, b []T, cmp func(a, b T) int) []T {
+	res := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		// 相等的时候优先取 a，保证稳定
+		if cmp(b[j], a[i]) < 0 {
+			res = append(res, b[j])
+			j++
+		} else {
+			res = append(res, a[i])
+			i++
+		}
+	}
+	res = append(res, a[i:]...)
+	return append(res, b[j:]...)
+}
@@ -0,0 +1,347 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSet(t *testing.T) {
+	testCases := []struct {
+		name          string
+		a             []int
+		b             []int
+		wantUnion     []int
+		wantIntersect []int
+		wantDiff      []int
+	}{
+		{
+			name:          "nil",
+			wantUnion:     []int{},
+			wantIntersect: []int{},
+			wantDiff:      []int{},
+		},
+		{
+			name:          "a nil",
+			b:             []int{1, 1, 2},
+			wantUnion:     []int{1, 2},
+			wantIntersect: []int{},
+			wantDiff:      []int{},
+		},
+		{
+			name:          "b nil",
+			a:             []int{1, 2, 2},
+			wantUnion:     []int{1, 2},
+			wantIntersect: []int{},
+			wantDiff:      []int{1, 2},
+		},
+		{
+			name:          "overlap with duplicates",
+			a:             []int{1, 2, 2, 3, 5, 5, 7},
+			b:             []int{2, 3, 3, 4, 5, 8},
+			wantUnion:     []int{1, 2, 3, 4, 5, 7, 8},
+			wantIntersect: []int{2, 3, 5},
+			wantDiff:      []int{1, 7},
+		},
+		{
+			name:          "disjoint",
+			a:             []int{1, 3},
+			b:             []int{2, 4},
+			wantUnion:     []int{1, 2, 3, 4},
+			wantIntersect: []int{},
+			wantDiff:      []int{1, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantUnion, SortedUnion(tc.a, tc.b))
+			assert.Equal(t, tc.wantIntersect, SortedIntersect(tc.a, tc.b))
+			assert.Equal(t, tc.wantDiff, SortedDiff(tc.a, tc.b))
+		})
+	}
+}
+
+func TestIndexOfSortedBy(t *testing.T) {
+	users := []indexUser{{ID: 1}, {ID: 3}, {ID: 3}, {ID: 5}, {ID: 9}}
+	testCases := []struct {
+		name      string
+		src       []indexUser
+		target    int
+		wantIdx   int
+		wantFound bool
+	}{
+		{
+			name:   "nil",
+			target: 1,
+		},
+		{
+			name:      "found first",
+			src:       users,
+			target:    1,
+			wantIdx:   0,
+			wantFound: true,
+		},
+		{
+			name:      "found duplicate",
+			src:       users,
+			target:    3,
+			wantIdx:   1,
+			wantFound: true,
+		},
+		{
+			name:      "found last",
+			src:       users,
+			target:    9,
+			wantIdx:   4,
+			wantFound: true,
+		},
+		{
+			name:    "miss middle",
+			src:     users,
+			target:  4,
+			wantIdx: 3,
+		},
+		{
+			name:    "miss before",
+			src:     users,
+			target:  0,
+			wantIdx: 0,
+		},
+		{
+			name:    "miss after",
+			src:     users,
+			target:  10,
+			wantIdx: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idx, found := IndexOfSortedBy(tc.src, func(u indexUser) int { return u.ID }, tc.target)
+			assert.Equal(t, tc.wantIdx, idx)
+			assert.Equal(t, tc.wantFound, found)
+		})
+	}
+}
+
+func TestMergeSortedCounts(t *testing.T) {
+	type pair = ekit.Pair[string, int]
+	testCases := []struct {
+		name string
+		a    []pair
+		b    []pair
+		want []pair
+	}{
+		{
+			name: "nil",
+			want: []pair{},
+		},
+		{
+			name: "b nil",
+			a:    []pair{{Key: "a", Value: 1}},
+			want: []pair{{Key: "a", Value: 1}},
+		},
+		{
+			name: "disjoint",
+			a:    []pair{{Key: "a", Value: 1}, {Key: "c", Value: 3}},
+			b:    []pair{{Key: "b", Value: 2}, {Key: "d", Value: 4}},
+			want: []pair{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "c", Value: 3}, {Key: "d", Value: 4}},
+		},
+		{
+			name: "overlapping and disjoint",
+			a:    []pair{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "d", Value: 1}},
+			b:    []pair{{Key: "b", Value: 5}, {Key: "c", Value: 1}, {Key: "d", Value: 2}, {Key: "e", Value: 7}},
+			want: []pair{{Key: "a", Value: 1}, {Key: "b", Value: 7}, {Key: "c", Value: 1}, {Key: "d", Value: 3}, {Key: "e", Value: 7}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := MergeSortedCounts(tc.a, tc.b)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		target    int
+		wantIdx   int
+		wantFound bool
+	}{
+		{
+			name:    "nil",
+			target:  1,
+			wantIdx: 0,
+		},
+		{
+			name:      "found",
+			src:       []int{1, 3, 5, 7},
+			target:    5,
+			wantIdx:   2,
+			wantFound: true,
+		},
+		{
+			name:      "found first of duplicates",
+			src:       []int{1, 3, 3, 3, 7},
+			target:    3,
+			wantIdx:   1,
+			wantFound: true,
+		},
+		{
+			name:    "insert in middle",
+			src:     []int{1, 3, 5, 7},
+			target:  4,
+			wantIdx: 2,
+		},
+		{
+			name:    "insert at head",
+			src:     []int{1, 3, 5, 7},
+			target:  0,
+			wantIdx: 0,
+		},
+		{
+			name:    "insert at tail",
+			src:     []int{1, 3, 5, 7},
+			target:  8,
+			wantIdx: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idx, found := BinarySearch(tc.src, tc.target)
+			assert.Equal(t, tc.wantIdx, idx)
+			assert.Equal(t, tc.wantFound, found)
+		})
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{ID: 1, Name: "Tom"}, {ID: 3, Name: "Jerry"}, {ID: 5, Name: "Spike"}}
+	cmp := func(a, b user) int {
+		return a.ID - b.ID
+	}
+	idx, found := BinarySearchFunc(users, user{ID: 3}, cmp)
+	assert.True(t, found)
+	assert.Equal(t, 1, idx)
+	idx, found = BinarySearchFunc(users, user{ID: 4}, cmp)
+	assert.False(t, found)
+	assert.Equal(t, 2, idx)
+}
+
+func TestSortedInsert(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		element int
+		want    []int
+	}{
+		{
+			name:    "nil",
+			element: 1,
+			want:    []int{1},
+		},
+		{
+			name:    "head",
+			src:     []int{2, 4},
+			element: 1,
+			want:    []int{1, 2, 4},
+		},
+		{
+			name:    "middle",
+			src:     []int{2, 4},
+			element: 3,
+			want:    []int{2, 3, 4},
+		},
+		{
+			name:    "tail",
+			src:     []int{2, 4},
+			element: 5,
+			want:    []int{2, 4, 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SortedInsert(tc.src, tc.element))
+		})
+	}
+}
+
+func TestSortedInsertFunc_Stable(t *testing.T) {
+	type task struct {
+		Priority int
+		Name     string
+	}
+	cmp := func(a, b task) int {
+		return a.Priority - b.Priority
+	}
+	var tasks []task
+	for _, tk := range []task{{2, "a"}, {1, "b"}, {2, "c"}, {3, "d"}, {2, "e"}} {
+		tasks = SortedInsertFunc(tasks, tk, cmp)
+	}
+	// 优先级相同的按照插入的顺序排列
+	assert.Equal(t, []task{{1, "b"}, {2, "a"}, {2, "c"}, {2, "e"}, {3, "d"}}, tasks)
+}
+
+func TestMergeSorted(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "a nil",
+			b:    []int{1, 2},
+			want: []int{1, 2},
+		},
+		{
+			name: "interleaved",
+			a:    []int{1, 4, 6},
+			b:    []int{2, 3, 7, 8},
+			want: []int{1, 2, 3, 4, 6, 7, 8},
+		},
+		{
+			name: "keep duplicates",
+			a:    []int{1, 2, 2},
+			b:    []int{2, 3},
+			want: []int{1, 2, 2, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := MergeSorted(tc.a, tc.b)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, len(tc.a)+len(tc.b), cap(res))
+		})
+	}
+}
+
+func TestMergeSortedFunc_Stable(t *testing.T) {
+	type record struct {
+		Key   int
+		Shard string
+	}
+	cmp := func(x, y record) int {
+		return x.Key - y.Key
+	}
+	a := []record{{1, "a"}, {2, "a"}, {2, "a2"}}
+	b := []record{{2, "b"}, {3, "b"}}
+	res := MergeSortedFunc(a, b, cmp)
+	assert.Equal(t, []record{{1, "a"}, {2, "a"}, {2, "a2"}, {2, "b"}, {3, "b"}}, res)
+}
@@ -0,0 +1,42 @@
+package slice
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lhh-gh/ekit"
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// Median 计算中位数
+// 长度为偶数的时候，返回中间两个数的平均值
+// src 为空时返回 errs.ErrEmptySlice，不会修改 src
+func Median[T ekit.RealNumber](src []T) (float64, error) {
+	return Percentile[T](src, 50)
+}
+
+// Percentile 计算第 p 百分位数，p 的取值范围是 [0, 100]，p 为 NaN 时返回错误
+// 采用线性插值，即排序后下标为 p/100*(len(src)-1) 的位置的值
+// src 为空时返回 errs.ErrEmptySlice，不会修改 src
+func Percentile[T ekit.RealNumber](src []T, p float64) (float64, error) {
+	if len(src) == 0 {
+		return 0, errs.ErrEmptySlice
+	}
+	// NaN 和任何数比较都是 false，需要单独判断
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		return 0, errs.NewErrInvalidPercentile(p)
+	}
+	sorted := make([]T, len(src))
+	copy(sorted, src)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower == len(sorted)-1 {
+		return float64(sorted[lower]), nil
+	}
+	frac := rank - float64(lower)
+	lo, hi := float64(sorted[lower]), float64(sorted[lower+1])
+	return lo + (hi-lo)*frac, nil
+}
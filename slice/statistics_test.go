@@ -0,0 +1,122 @@
+package slice
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedian(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		want    float64
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: errs.ErrEmptySlice,
+		},
+		{
+			name: "single",
+			src:  []int{3},
+			want: 3,
+		},
+		{
+			name: "odd",
+			src:  []int{5, 1, 3},
+			want: 3,
+		},
+		{
+			name: "even",
+			src:  []int{4, 1, 3, 2},
+			want: 2.5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			origin := append([]int(nil), tc.src...)
+			res, err := Median(tc.src)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+			// 不会修改输入
+			assert.Equal(t, origin, tc.src)
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	hundred := make([]float64, 0, 100)
+	for i := 100; i >= 1; i-- {
+		hundred = append(hundred, float64(i))
+	}
+	testCases := []struct {
+		name    string
+		src     []float64
+		p       float64
+		want    float64
+		wantErr error
+	}{
+		{
+			name:    "empty",
+			src:     []float64{},
+			p:       50,
+			wantErr: errs.ErrEmptySlice,
+		},
+		{
+			name:    "p less than 0",
+			src:     []float64{1},
+			p:       -1,
+			wantErr: errs.NewErrInvalidPercentile(-1),
+		},
+		{
+			name:    "p greater than 100",
+			src:     []float64{1},
+			p:       100.5,
+			wantErr: errs.NewErrInvalidPercentile(100.5),
+		},
+		{
+			name:    "p NaN",
+			src:     []float64{1},
+			p:       math.NaN(),
+			wantErr: errs.NewErrInvalidPercentile(math.NaN()),
+		},
+		{
+			name: "p 0",
+			src:  hundred,
+			p:    0,
+			want: 1,
+		},
+		{
+			name: "p 100",
+			src:  hundred,
+			p:    100,
+			want: 100,
+		},
+		{
+			name: "p 95",
+			src:  hundred,
+			p:    95,
+			want: 95.05,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Percentile(tc.src, tc.p)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.InDelta(t, tc.want, res, 1e-9)
+		})
+	}
+	// 不会修改输入
+	assert.Equal(t, float64(100), hundred[0])
+}
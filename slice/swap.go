@@ -0,0 +1,37 @@
+package slice
+
+import "github.com/lhh-gh/ekit/internal/errs"
+
+// Swap 原地交换 i 和 j 处的元素
+// i 和 j 的范围都应为[0, len(src))，否则返回错误并且不修改 src
+func Swap[T any](src []T, i, j int) error {
+	if i < 0 || i >= len(src) {
+		return errs.NewErrIndexOutOfRange(len(src), i)
+	}
+	if j < 0 || j >= len(src) {
+		return errs.NewErrIndexOutOfRange(len(src), j)
+	}
+	src[i], src[j] = src[j], src[i]
+	return nil
+}
+
+// Move 将 from 处的元素移动到 to 处，中间的元素依次前移或者后移一位
+// 例如 Move([]int{1, 2, 3, 4}, 0, 2) 的结果是 [2, 3, 1, 4]
+// 适合拖拽排序之类的场景；原地修改，返回的切片和 src 共享底层数组
+// from 和 to 的范围都应为[0, len(src))，from == to 时不做任何修改
+func Move[T any](src []T, from, to int) ([]T, error) {
+	if from < 0 || from >= len(src) {
+		return nil, errs.NewErrIndexOutOfRange(len(src), from)
+	}
+	if to < 0 || to >= len(src) {
+		return nil, errs.NewErrIndexOutOfRange(len(src), to)
+	}
+	val := src[from]
+	if from < to {
+		copy(src[from:to], src[from+1:to+1])
+	} else {
+		copy(src[to+1:from+1], src[to:from])
+	}
+	src[to] = val
+	return src, nil
+}
@@ -0,0 +1,123 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwap(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		i         int
+		j         int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "normal",
+			src:       []int{1, 2, 3},
+			i:         0,
+			j:         2,
+			wantSlice: []int{3, 2, 1},
+		},
+		{
+			name:      "same index",
+			src:       []int{1, 2, 3},
+			i:         1,
+			j:         1,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "i out of range",
+			src:       []int{1, 2, 3},
+			i:         3,
+			j:         0,
+			wantSlice: []int{1, 2, 3},
+			wantErr:   errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:      "j less than 0",
+			src:       []int{1, 2, 3},
+			i:         0,
+			j:         -1,
+			wantSlice: []int{1, 2, 3},
+			wantErr:   errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Swap(tc.src, tc.i, tc.j)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantSlice, tc.src)
+		})
+	}
+}
+
+func TestMove(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		from      int
+		to        int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "forward",
+			src:       []int{1, 2, 3, 4, 5},
+			from:      1,
+			to:        3,
+			wantSlice: []int{1, 3, 4, 2, 5},
+		},
+		{
+			name:      "backward",
+			src:       []int{1, 2, 3, 4, 5},
+			from:      4,
+			to:        0,
+			wantSlice: []int{5, 1, 2, 3, 4},
+		},
+		{
+			name:      "to last",
+			src:       []int{1, 2, 3},
+			from:      0,
+			to:        2,
+			wantSlice: []int{2, 3, 1},
+		},
+		{
+			name:      "from == to",
+			src:       []int{1, 2, 3},
+			from:      1,
+			to:        1,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:    "from out of range",
+			src:     []int{1, 2, 3},
+			from:    3,
+			to:      0,
+			wantErr: errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:    "to less than 0",
+			src:     []int{1, 2, 3},
+			from:    0,
+			to:      -1,
+			wantErr: errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Move(tc.src, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package slice
+
+// Tap 对每一个元素调用 fn，然后原样返回 src
+// 不会修改也不会复制 src，一般用于在 Map、Filter 之类的链式调用中插入日志、打点等副作用
+func Tap[T any](src []T, fn func(idx int, t T)) []T {
+	for i, v := range src {
+		fn(i, v)
+	}
+	return src
+}
@@ -0,0 +1,44 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTap(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "values",
+			src:  []int{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				idxs []int
+				vals []int
+			)
+			res := Tap(tc.src, func(idx int, t int) {
+				idxs = append(idxs, idx)
+				vals = append(vals, t)
+			})
+			assert.Equal(t, tc.src, vals)
+			for i, idx := range idxs {
+				assert.Equal(t, i, idx)
+			}
+			assert.Equal(t, len(tc.src), len(res))
+			if len(tc.src) > 0 {
+				// 同一个底层数组
+				assert.Same(t, &tc.src[0], &res[0])
+			}
+		})
+	}
+}
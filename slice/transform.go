@@ -0,0 +1,67 @@
+package slice
+
+// Map 将 src 中的每一个元素通过 mapper 转化为 Dst，返回转化后的切片
+// 结果会预先分配 len(src) 的容量；src 为 nil 的时候返回 nil，方便调用者区分
+func Map[Src any, Dst any](src []Src, mapper func(idx int, src Src) Dst) []Dst {
+	if src == nil {
+		return nil
+	}
+	res := make([]Dst, len(src))
+	for i, s := range src {
+		res[i] = mapper(i, s)
+	}
+	return res
+}
+
+// MapInto 将 src 中的元素通过 m 转化之后追加到 dst 的末尾，并返回追加后的切片
+// dst 原有的元素会被保留；调用者可以复用 dst 的容量，减少热点路径上的内存分配，
+// 例如传入 buf[:0] 来复用同一个缓冲区
+func MapInto[Src any, Dst any](dst []Dst, src []Src, m func(idx int, s Src) Dst) []Dst {
+	if free := cap(dst) - len(dst); free < len(src) {
+		grown := make([]Dst, len(dst), len(dst)+len(src))
+		copy(grown, dst)
+		dst = grown
+	}
+	for i, s := range src {
+		dst = append(dst, m(i, s))
+	}
+	return dst
+}
+
+// MapAccum 在转化每一个元素的同时传递一个累加器，返回最终的累加器以及转化后的切片
+// fn 的第一个参数是当前的累加器，返回新的累加器以及当前元素转化后的结果
+// 例如在转化的同时为元素分配递增的序号
+func MapAccum[Src any, Dst any, Acc any](src []Src, init Acc,
+	fn func(acc Acc, idx int, s Src) (Acc, Dst)) (Acc, []Dst) {
+	acc := init
+	res := make([]Dst, 0, len(src))
+	for i, s := range src {
+		var dst Dst
+		acc, dst = fn(acc, i, s)
+		res = append(res, dst)
+	}
+	return acc, res
+}
+
+// Reduce 从 init 开始，依次将 src 中的元素通过 reducer 累加，返回最终的结果
+// reducer 会收到元素的下标，方便实现和位置相关的聚合
+// src 为空的时候直接返回 init
+func Reduce[T any, R any](src []T, init R, reducer func(acc R, idx int, elem T) R) R {
+	acc := init
+	for i, v := range src {
+		acc = reducer(acc, i, v)
+	}
+	return acc
+}
+
+// FlatMap 对每一个元素调用 mapper，并将返回的切片按照顺序拼接起来
+// 例如将订单展开为订单项；mapper 返回 nil 时被当作空切片处理
+// 由于无法提前知道结果的长度，结果切片会随着追加自动扩容；
+// 如果结果很长并且在意内存分配，可以使用 Flatten(Map(...))，它会先计算出总长度再一次性分配
+func FlatMap[Src any, Dst any](src []Src, mapper func(idx int, s Src) []Dst) []Dst {
+	res := make([]Dst, 0, len(src))
+	for i, s := range src {
+		res = append(res, mapper(i, s)...)
+	}
+	return res
+}
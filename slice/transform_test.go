@@ -0,0 +1,267 @@
+package slice
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "empty",
+			src:  []int{},
+			want: []string{},
+		},
+		{
+			name: "normal",
+			src:  []int{1, 2, 3},
+			want: []string{"0:1", "1:2", "2:3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Map(tc.src, func(idx int, s int) string {
+				return strconv.Itoa(idx) + ":" + strconv.Itoa(s)
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestMapInto(t *testing.T) {
+	testCases := []struct {
+		name string
+		dst  []string
+		src  []int
+		want []string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "nil dst",
+			src:  []int{1, 2},
+			want: []string{"0:1", "1:2"},
+		},
+		{
+			name: "append to dst",
+			dst:  []string{"a"},
+			src:  []int{1, 2},
+			want: []string{"a", "0:1", "1:2"},
+		},
+		{
+			name: "enough capacity",
+			dst:  make([]string, 1, 10),
+			src:  []int{3},
+			want: []string{"", "0:3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := MapInto(tc.dst, tc.src, func(idx int, s int) string {
+				return strconv.Itoa(idx) + ":" + strconv.Itoa(s)
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestMapInto_Reuse(t *testing.T) {
+	buf := make([]int, 0, 8)
+	res := MapInto(buf, []int{1, 2, 3}, func(idx int, s int) int { return s * 2 })
+	assert.Equal(t, []int{2, 4, 6}, res)
+	// 容量足够的时候复用 dst 的底层数组
+	assert.Same(t, &buf[:1][0], &res[0])
+}
+
+func BenchmarkMapInto(b *testing.B) {
+	src := make([]int, 1024)
+	for i := range src {
+		src[i] = i
+	}
+	double := func(idx int, s int) int { return s * 2 }
+	b.Run("new dst", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = MapInto(nil, src, double)
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Map(src, double)
+		}
+	})
+	b.Run("reuse dst", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]int, 0, len(src))
+		for i := 0; i < b.N; i++ {
+			buf = MapInto(buf[:0], src, double)
+		}
+	})
+}
+
+func TestMapAccum(t *testing.T) {
+	type seqItem struct {
+		Seq  int
+		Name string
+	}
+	testCases := []struct {
+		name    string
+		src     []string
+		init    int
+		wantAcc int
+		want    []seqItem
+	}{
+		{
+			name:    "nil",
+			init:    10,
+			wantAcc: 10,
+			want:    []seqItem{},
+		},
+		{
+			name:    "running sequence",
+			src:     []string{"a", "", "b", "c"},
+			init:    100,
+			wantAcc: 103,
+			want: []seqItem{
+				{Seq: 100, Name: "a"},
+				{Seq: 0, Name: ""},
+				{Seq: 101, Name: "b"},
+				{Seq: 102, Name: "c"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// 空字符串不分配序号
+			acc, res := MapAccum(tc.src, tc.init, func(acc int, idx int, s string) (int, seqItem) {
+				if s == "" {
+					return acc, seqItem{}
+				}
+				return acc + 1, seqItem{Seq: acc, Name: s}
+			})
+			assert.Equal(t, tc.wantAcc, acc)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		init string
+		want string
+	}{
+		{
+			name: "nil",
+			init: "init",
+			want: "init",
+		},
+		{
+			name: "empty",
+			src:  []int{},
+			init: "init",
+			want: "init",
+		},
+		{
+			name: "concat",
+			src:  []int{1, 2, 3},
+			init: ">",
+			want: ">0:1,1:2,2:3,",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Reduce(tc.src, tc.init, func(acc string, idx int, elem int) string {
+				return acc + strconv.Itoa(idx) + ":" + strconv.Itoa(elem) + ","
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestReduce_Sum(t *testing.T) {
+	res := Reduce([]int{1, 2, 3, 4}, 0, func(acc int, idx int, elem int) int {
+		return acc + elem
+	})
+	assert.Equal(t, 10, res)
+}
+
+func TestFlatMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []string
+	}{
+		{
+			name: "nil",
+			want: []string{},
+		},
+		{
+			name: "expand",
+			src:  []int{1, 2, 3},
+			want: []string{"0:1", "1:2", "1:2", "2:3", "2:3", "2:3"},
+		},
+		{
+			name: "nil from mapper",
+			src:  []int{0, 1, 0},
+			want: []string{"1:1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FlatMap(tc.src, func(idx int, s int) []string {
+				if s == 0 {
+					return nil
+				}
+				item := strconv.Itoa(idx) + ":" + strconv.Itoa(s)
+				res := make([]string, s)
+				for i := range res {
+					res[i] = item
+				}
+				return res
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	src := make([]int, 1024)
+	for i := range src {
+		src[i] = i
+	}
+	items := []int{1, 2, 3, 4}
+	b.Run("FlatMap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = FlatMap(src, func(idx int, s int) []int {
+				return items
+			})
+		}
+	})
+	b.Run("Map then Flatten", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Flatten(Map(src, func(idx int, s int) []int {
+				return items
+			}))
+		}
+	})
+}
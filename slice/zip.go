@@ -0,0 +1,41 @@
+package slice
+
+import (
+	"github.com/lhh-gh/ekit"
+	"github.com/lhh-gh/ekit/internal/errs"
+)
+
+// Zip 将 a 和 b 中相同位置的元素组成 Pair，例如将并行的 key 切片和 value 切片组合起来
+// a 和 b 的长度不一致时返回错误；不需要校验长度的时候可以使用 ZipTruncate
+func Zip[A any, B any](a []A, b []B) ([]ekit.Pair[A, B], error) {
+	if len(a) != len(b) {
+		return nil, errs.NewErrLengthMismatch(len(a), len(b))
+	}
+	return ZipTruncate(a, b), nil
+}
+
+// ZipTruncate 和 Zip 一样，只是在较短的切片结束的时候停止，而不是返回错误
+func ZipTruncate[A any, B any](a []A, b []B) []ekit.Pair[A, B] {
+	return ZipWith(a, b, ekit.NewPair[A, B])
+}
+
+// Unzip 是 Zip 的逆操作，将 Pair 拆分为两个切片
+func Unzip[A any, B any](pairs []ekit.Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.Key, p.Value
+	}
+	return as, bs
+}
+
+// ZipWith 同时遍历 a 和 b，对相同位置的元素调用 combine，组成新的切片
+// 结果的长度是 a 和 b 中较短的那个的长度
+func ZipWith[A any, B any, C any](a []A, b []B, combine func(A, B) C) []C {
+	n := min(len(a), len(b))
+	res := make([]C, 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, combine(a[i], b[i]))
+	}
+	return res
+}
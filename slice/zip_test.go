@@ -0,0 +1,109 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/lhh-gh/ekit"
+	"github.com/lhh-gh/ekit/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a       []string
+		b       []int
+		want    []ekit.Pair[string, int]
+		wantErr error
+	}{
+		{
+			name: "nil",
+			want: []ekit.Pair[string, int]{},
+		},
+		{
+			name: "normal",
+			a:    []string{"a", "b"},
+			b:    []int{1, 2},
+			want: []ekit.Pair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name:    "length mismatch",
+			a:       []string{"a", "b"},
+			b:       []int{1},
+			wantErr: errs.NewErrLengthMismatch(2, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Zip(tc.a, tc.b)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, res)
+			// Unzip 是 Zip 的逆操作
+			as, bs := Unzip(res)
+			assert.Equal(t, len(tc.a), len(as))
+			assert.Equal(t, len(tc.b), len(bs))
+			for i := range as {
+				assert.Equal(t, tc.a[i], as[i])
+				assert.Equal(t, tc.b[i], bs[i])
+			}
+		})
+	}
+}
+
+func TestZipTruncate(t *testing.T) {
+	res := ZipTruncate([]string{"a", "b", "c"}, []int{1, 2})
+	assert.Equal(t, []ekit.Pair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, res)
+	res = ZipTruncate([]string{"a"}, []int{1, 2})
+	assert.Equal(t, []ekit.Pair[string, int]{{Key: "a", Value: 1}}, res)
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := Unzip[string, int](nil)
+	assert.Equal(t, []string{}, as)
+	assert.Equal(t, []int{}, bs)
+}
+
+func TestZipWith(t *testing.T) {
+	testCases := []struct {
+		name       string
+		prices     []float64
+		quantities []int
+		want       []float64
+	}{
+		{
+			name: "nil",
+			want: []float64{},
+		},
+		{
+			name:       "same length",
+			prices:     []float64{1.5, 2, 10},
+			quantities: []int{2, 3, 1},
+			want:       []float64{3, 6, 10},
+		},
+		{
+			name:       "prices shorter",
+			prices:     []float64{1.5},
+			quantities: []int{2, 3, 1},
+			want:       []float64{3},
+		},
+		{
+			name:       "quantities shorter",
+			prices:     []float64{1.5, 2, 10},
+			quantities: []int{2, 3},
+			want:       []float64{3, 6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ZipWith(tc.prices, tc.quantities, func(p float64, q int) float64 {
+				return p * float64(q)
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
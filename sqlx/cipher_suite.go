@@ -0,0 +1,71 @@
+package sqlx
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// CipherSuite EncryptColumn 使用的加密算法
+type CipherSuite byte
+
+const (
+	// CipherSuiteAESGCM AES-GCM，默认的加密算法
+	// 为了兼容已经存储的数据，使用该算法加密的密文不带头部
+	CipherSuiteAESGCM CipherSuite = iota
+	// CipherSuiteChaCha20Poly1305 ChaCha20-Poly1305，在没有 AES 硬件加速的平台上比 AES-GCM 更快
+	// 密钥必须为 32 字节
+	CipherSuiteChaCha20Poly1305
+)
+
+// cipherSuiteMagic 密文头部的第一个字节，第二个字节是 CipherSuite
+// 只有非默认的算法才会写入头部
+const cipherSuiteMagic byte = 0xEC
+
+var (
+	errChaChaKeyLengthInvalid = errors.New("ekit EncryptColumn ChaCha20-Poly1305 仅支持 32 byte 的key")
+	errCiphertextTooShort     = errors.New("ekit EncryptColumn 密文长度不足")
+)
+
+// String 返回算法的名字
+func (s CipherSuite) String() string {
+	switch s {
+	case CipherSuiteAESGCM:
+		return "AES-GCM"
+	case CipherSuiteChaCha20Poly1305:
+		return "ChaCha20-Poly1305"
+	default:
+		return fmt.Sprintf("CipherSuite(%d)", byte(s))
+	}
+}
+
+// newAEAD 根据算法和密钥构造 AEAD
+func newAEAD(suite CipherSuite, key []byte) (cipher.AEAD, error) {
+	switch suite {
+	case CipherSuiteAESGCM:
+		return newAESGCM(key)
+	case CipherSuiteChaCha20Poly1305:
+		if len(key) != chacha20poly1305.KeySize {
+			return nil, errChaChaKeyLengthInvalid
+		}
+		return chacha20poly1305.New(key)
+	default:
+		return nil, fmt.Errorf("ekit EncryptColumn 不支持的加密算法 %s", suite)
+	}
+}
+
+// parseCipherSuiteHeader 解析密文头部，返回算法以及去掉头部之后的数据
+// 没有头部的时候 ok 为 false，此时应该按照默认的 AES-GCM 处理
+func parseCipherSuiteHeader(data []byte) (suite CipherSuite, body []byte, ok bool) {
+	if len(data) < 2 || data[0] != cipherSuiteMagic {
+		return CipherSuiteAESGCM, data, false
+	}
+	suite = CipherSuite(data[1])
+	if suite == CipherSuiteAESGCM {
+		// 默认算法不会写入头部
+		return CipherSuiteAESGCM, data, false
+	}
+	return suite, data[2:], true
+}
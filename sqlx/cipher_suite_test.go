@@ -0,0 +1,96 @@
+package sqlx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptColumn_CipherSuite(t *testing.T) {
+	key := "ABCDABCDABCDABCDABCDABCDABCDABCD"
+	testCases := []struct {
+		name       string
+		suite      CipherSuite
+		wantHeader bool
+	}{
+		{
+			name:  "aes gcm without header",
+			suite: CipherSuiteAESGCM,
+		},
+		{
+			name:       "chacha20 poly1305",
+			suite:      CipherSuiteChaCha20Poly1305,
+			wantHeader: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := EncryptColumn[string]{Key: key, Val: "hello", Valid: true, Suite: tc.suite}.Value()
+			require.NoError(t, err)
+			encrypted := val.([]byte)
+			// nonce 12 字节，认证标签 16 字节
+			if tc.wantHeader {
+				assert.Equal(t, []byte{cipherSuiteMagic, byte(tc.suite)}, encrypted[:2])
+				assert.Equal(t, 2+12+5+16, len(encrypted))
+			} else {
+				assert.Equal(t, 12+5+16, len(encrypted))
+			}
+
+			// Scan 按照头部选择算法，不依赖 Suite 字段
+			col := &EncryptColumn[string]{Key: key}
+			require.NoError(t, col.Scan(encrypted))
+			assert.Equal(t, "hello", col.Val)
+			assert.True(t, col.Valid)
+		})
+	}
+}
+
+func TestEncryptColumn_CipherSuiteFactory(t *testing.T) {
+	key := "ABCDABCDABCDABCDABCDABCDABCDABCD"
+	f, err := NewEncryptColumnFactory[string](key)
+	require.NoError(t, err)
+	val, err := EncryptColumn[string]{Key: key, Val: "hello", Valid: true, Suite: CipherSuiteChaCha20Poly1305}.Value()
+	require.NoError(t, err)
+	// 工厂预先构造的是 AES-GCM，解密 ChaCha20-Poly1305 的密文时重新构造
+	col := f.NewPtr()
+	require.NoError(t, col.Scan(val))
+	assert.Equal(t, "hello", col.Val)
+}
+
+func TestEncryptColumn_CipherSuiteLegacyFallback(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	// 构造一个 nonce 恰好以头部字节开头的旧格式密文
+	aead, err := newAESGCM([]byte(key))
+	require.NoError(t, err)
+	nonce := make([]byte, aead.NonceSize())
+	nonce[0], nonce[1] = cipherSuiteMagic, byte(CipherSuiteChaCha20Poly1305)
+	encrypted := aead.Seal(nonce, nonce, []byte("hello"), nil)
+
+	col := &EncryptColumn[string]{Key: key}
+	require.NoError(t, col.Scan(encrypted))
+	assert.Equal(t, "hello", col.Val)
+}
+
+func TestEncryptColumn_CipherSuiteErr(t *testing.T) {
+	// ChaCha20-Poly1305 只支持 32 字节的密钥
+	_, err := EncryptColumn[string]{Key: "ABCDABCDABCDABCD", Val: "hello", Valid: true,
+		Suite: CipherSuiteChaCha20Poly1305}.Value()
+	assert.Equal(t, errChaChaKeyLengthInvalid, err)
+
+	// 未知的算法
+	_, err = EncryptColumn[string]{Key: "ABCDABCDABCDABCD", Val: "hello", Valid: true,
+		Suite: CipherSuite(100)}.Value()
+	assert.EqualError(t, err, "ekit EncryptColumn 不支持的加密算法 CipherSuite(100)")
+
+	// 密文过短
+	col := &EncryptColumn[string]{Key: "ABCDABCDABCDABCD"}
+	assert.Equal(t, errCiphertextTooShort, col.Scan([]byte{1, 2, 3}))
+}
+
+func TestCipherSuite_String(t *testing.T) {
+	assert.Equal(t, "AES-GCM", CipherSuiteAESGCM.String())
+	assert.Equal(t, "ChaCha20-Poly1305", CipherSuiteChaCha20Poly1305.String())
+	assert.Equal(t, "CipherSuite(9)", CipherSuite(9).String())
+}
@@ -0,0 +1,32 @@
+package sqlx
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ColumnCodec 自定义类型 T 的序列化方式
+// EncryptColumn 和 SecureField 在加密之前都会先序列化，
+// 对于没有内置处理的类型，如果注册了 ColumnCodec，就会用它代替默认的 JSON 或者 msgpack，
+// 因此同一个类型无论使用哪一种加密字段，序列化的结果都是一致的
+type ColumnCodec[T any] interface {
+	Encode(val T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+var columnCodecs sync.Map
+
+// RegisterColumnCodec 为类型 T 注册 ColumnCodec，重复注册会覆盖之前的
+// 一般在 init 阶段注册
+func RegisterColumnCodec[T any](codec ColumnCodec[T]) {
+	columnCodecs.Store(reflect.TypeOf((*T)(nil)).Elem(), codec)
+}
+
+// columnCodecOf 查找为类型 T 注册的 ColumnCodec
+func columnCodecOf[T any]() (ColumnCodec[T], bool) {
+	codec, ok := columnCodecs.Load(reflect.TypeOf((*T)(nil)).Elem())
+	if !ok {
+		return nil, false
+	}
+	return codec.(ColumnCodec[T]), true
+}
@@ -0,0 +1,93 @@
+package sqlx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type codecPoint struct {
+	X int
+	Y int
+}
+
+// pointCodec 将 codecPoint 编码为 "X,Y"
+type pointCodec struct{}
+
+func (pointCodec) Encode(val codecPoint) ([]byte, error) {
+	return []byte(fmt.Sprintf("%d,%d", val.X, val.Y)), nil
+}
+
+func (pointCodec) Decode(data []byte) (codecPoint, error) {
+	var p codecPoint
+	_, err := fmt.Sscanf(string(data), "%d,%d", &p.X, &p.Y)
+	return p, err
+}
+
+type brokenCodecVal struct {
+	Name string
+}
+
+type brokenCodec struct{}
+
+func (brokenCodec) Encode(val brokenCodecVal) ([]byte, error) {
+	return []byte(val.Name), nil
+}
+
+func (brokenCodec) Decode(data []byte) (brokenCodecVal, error) {
+	return brokenCodecVal{}, errors.New("mock error")
+}
+
+func TestColumnCodec(t *testing.T) {
+	RegisterColumnCodec[codecPoint](pointCodec{})
+	key := "ABCDABCDABCDABCD"
+	val := codecPoint{X: 1, Y: 2}
+
+	// EncryptColumn 使用注册的 codec
+	encrypted, err := EncryptColumn[codecPoint]{Key: key, Val: val, Valid: true}.Value()
+	require.NoError(t, err)
+	raw := &EncryptColumn[string]{Key: key}
+	require.NoError(t, raw.Scan(encrypted))
+	assert.Equal(t, "1,2", raw.Val)
+	col := &EncryptColumn[codecPoint]{Key: key}
+	require.NoError(t, col.Scan(encrypted))
+	assert.Equal(t, val, col.Val)
+
+	// SecureField 使用同一个 codec
+	sf := NewSecureField([]byte(key), val)
+	encrypted, err = sf.Value()
+	require.NoError(t, err)
+	raw = &EncryptColumn[string]{Key: key}
+	require.NoError(t, raw.Scan(encrypted))
+	assert.Equal(t, "1,2", raw.Val)
+	res := NewSecureField([]byte(key), codecPoint{})
+	require.NoError(t, res.Scan(encrypted))
+	assert.Equal(t, val, res.Get())
+
+	// 两种加密字段可以互相读取
+	col = &EncryptColumn[codecPoint]{Key: key}
+	require.NoError(t, col.Scan(encrypted))
+	assert.Equal(t, val, col.Val)
+}
+
+func TestColumnCodec_DecodeError(t *testing.T) {
+	RegisterColumnCodec[brokenCodecVal](brokenCodec{})
+	key := "ABCDABCDABCDABCD"
+	val := brokenCodecVal{Name: "Tom"}
+
+	encrypted, err := EncryptColumn[brokenCodecVal]{Key: key, Val: val, Valid: true}.Value()
+	require.NoError(t, err)
+	col := &EncryptColumn[brokenCodecVal]{Key: key}
+	err = col.Scan(encrypted)
+	assert.EqualError(t, err, "mock error")
+	assert.False(t, col.Valid)
+
+	sf := NewSecureField([]byte(key), val)
+	encrypted, err = sf.Value()
+	require.NoError(t, err)
+	err = NewSecureField([]byte(key), brokenCodecVal{}).Scan(encrypted)
+	assert.EqualError(t, err, "数据反序列化失败: mock error")
+}
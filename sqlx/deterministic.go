@@ -0,0 +1,30 @@
+package sqlx
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// deterministicNonceLabel 从密钥派生 HMAC 密钥时使用的标签
+// 避免直接把加密密钥同时用作 HMAC 密钥
+var deterministicNonceLabel = []byte("ekit EncryptColumn deterministic nonce")
+
+// deriveDeterministicNonce 使用 SIV 风格的方式从密钥、AAD 和明文派生 nonce：
+// macKey = HMAC-SHA256(key, deterministicNonceLabel)
+// nonce = HMAC-SHA256(macKey, len(aad) || aad || plaintext) 的前 size 个字节
+// AAD 参与派生，保证只有 AAD 和明文都相同的时候才会出现重复的 nonce，
+// 此时密文也完全相同，不会出现 GCM 中 nonce 重复导致的密钥泄露
+func deriveDeterministicNonce(key string, aad []byte, plaintext []byte, size int) []byte {
+	keyMac := hmac.New(sha256.New, []byte(key))
+	keyMac.Write(deterministicNonceLabel)
+	macKey := keyMac.Sum(nil)
+
+	mac := hmac.New(sha256.New, macKey)
+	var aadLen [8]byte
+	binary.BigEndian.PutUint64(aadLen[:], uint64(len(aad)))
+	mac.Write(aadLen[:])
+	mac.Write(aad)
+	mac.Write(plaintext)
+	return mac.Sum(nil)[:size]
+}
@@ -0,0 +1,119 @@
+package sqlx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptColumn_Deterministic(t *testing.T) {
+	testCases := []struct {
+		name string
+		col  EncryptColumn[string]
+	}{
+		{
+			name: "aes gcm",
+			col:  EncryptColumn[string]{Key: "ABCDABCDABCDABCD"},
+		},
+		{
+			name: "chacha20 poly1305",
+			col: EncryptColumn[string]{Key: "ABCDABCDABCDABCDABCDABCDABCDABCD",
+				Suite: CipherSuiteChaCha20Poly1305},
+		},
+		{
+			name: "key version",
+			col:  EncryptColumn[string]{Keys: map[byte]string{1: "ABCDABCDABCDABCD"}, KeyVersion: 1},
+		},
+		{
+			name: "aad",
+			col:  EncryptColumn[string]{Key: "ABCDABCDABCDABCD", AAD: []byte("tenant:1")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encrypt := func(val string) []byte {
+				col := tc.col
+				col.Val, col.Valid, col.Deterministic = val, true, true
+				res, err := col.Value()
+				require.NoError(t, err)
+				return res.([]byte)
+			}
+			first := encrypt("tom@example.com")
+			assert.Equal(t, first, encrypt("tom@example.com"))
+			assert.NotEqual(t, first, encrypt("jerry@example.com"))
+
+			// 解密不需要设置 Deterministic
+			col := tc.col
+			require.NoError(t, col.Scan(first))
+			assert.Equal(t, "tom@example.com", col.Val)
+		})
+	}
+}
+
+func TestEncryptColumn_DeterministicAAD(t *testing.T) {
+	encrypt := func(aad string) []byte {
+		res, err := EncryptColumn[string]{Key: "ABCDABCDABCDABCD", Val: "hello", Valid: true,
+			Deterministic: true}.WithAAD([]byte(aad)).Value()
+		require.NoError(t, err)
+		return res.([]byte)
+	}
+	// AAD 不同的时候 nonce 也不同
+	assert.NotEqual(t, encrypt("user:1")[:12], encrypt("user:2")[:12])
+}
+
+func TestEncryptColumn_Random(t *testing.T) {
+	col := EncryptColumn[string]{Key: "ABCDABCDABCDABCD", Val: "hello", Valid: true}
+	first, err := col.Value()
+	require.NoError(t, err)
+	second, err := col.Value()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestDeriveDeterministicNonce(t *testing.T) {
+	testCases := []struct {
+		name      string
+		key       string
+		aad       []byte
+		plaintext []byte
+		same      bool
+	}{
+		{
+			name:      "same input",
+			key:       "ABCDABCDABCDABCD",
+			plaintext: []byte("hello"),
+			same:      true,
+		},
+		{
+			name:      "different key",
+			key:       "BCDABCDABCDABCDA",
+			plaintext: []byte("hello"),
+		},
+		{
+			name:      "different plaintext",
+			key:       "ABCDABCDABCDABCD",
+			plaintext: []byte("hellO"),
+		},
+		{
+			// 长度前缀保证 AAD 和明文的边界不会混淆
+			name:      "aad boundary",
+			key:       "ABCDABCDABCDABCD",
+			aad:       []byte("h"),
+			plaintext: []byte("ello"),
+		},
+	}
+	want := deriveDeterministicNonce("ABCDABCDABCDABCD", nil, []byte("hello"), 12)
+	assert.Len(t, want, 12)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := deriveDeterministicNonce(tc.key, tc.aad, tc.plaintext, 12)
+			if tc.same {
+				assert.Equal(t, want, res)
+				return
+			}
+			assert.NotEqual(t, want, res)
+		})
+	}
+}
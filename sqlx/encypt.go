@@ -2,6 +2,7 @@ package sqlx
 
 import (
 	"bytes"
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -11,16 +12,58 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
+	"sync"
+	"time"
 )
 
-// EncryptColumn 代表一个加密的数据库列，使用AES-GCM模式进行加密和解密。
+// EncryptColumn 代表一个加密的数据库列，默认使用AES-GCM模式进行加密和解密。
 // 泛型参数T表示被加密数据的类型。
 // 注意：Key必须是16、24或32字节长度的字符串（对应AES-128、AES-192、AES-256）。
+// 通过 Suite 可以选择其它加密算法，例如 ChaCha20-Poly1305。
 // Valid标记该值是否有效，类似于sql.Null类型的行为。
 type EncryptColumn[T any] struct {
 	Val   T      // 存储实际的值，类型由泛型T指定
 	Valid bool   // 标记值是否有效，为false时Value返回nil
 	Key   string // 加密密钥，必须为16/24/32字节长度
+	// KeyProvider 不为 nil 的时候代替 Key，在 Value 和 Scan 中按需调用以获取密钥
+	// 适合密钥保存在 KMS 之类的外部系统中，不希望在代码和配置中出现明文密钥的场景
+	KeyProvider KeyProvider
+	// Keys 按照版本号索引的密钥，用于密钥轮换
+	// 不为 nil 的时候 Value 使用 Keys[KeyVersion] 加密，并在密文头部记录算法和版本号，
+	// Scan 则根据头部记录的版本号选择对应的密钥；
+	// 没有版本号头部的旧密文依旧使用 Key（或者 KeyProvider）解密，因此迁移期间 Key 应该保留为旧的密钥
+	Keys map[byte]string
+	// KeyVersion 加密时使用的密钥版本，只在 Keys 不为 nil 的时候生效
+	KeyVersion byte
+	// AAD 附加认证数据，会参与加密和解密的认证，但是不会被加密，也不会被存储
+	// 例如传入行的主键，将密文和这一行绑定，密文被复制到其它行之后就无法解密
+	// 调用者需要保证读和写的时候传入相同的 AAD
+	AAD []byte
+	// Suite 加密使用的算法，默认为 AES-GCM
+	// 非默认算法的密文会带上两个字节的头部记录算法，因此 Scan 不依赖该字段，
+	// 始终能按照密文实际使用的算法解密；没有头部的密文按照 AES-GCM 解密
+	Suite CipherSuite
+	// Deterministic 为 true 的时候使用确定性加密：nonce 由密钥、AAD 和明文通过 HMAC-SHA256 派生，
+	// 相同的 key、AAD 和明文总是得到完全相同的密文，因此可以使用 WHERE email_enc = ? 查询和建立索引
+	//
+	// 警告：确定性加密会泄露明文是否相等。攻击者能够看出哪些行的值相同、统计每个值出现的次数，
+	// 对于取值范围小的字段（例如性别、状态）几乎等同于明文。只应该在必须等值查询的列上开启，
+	// 并且尽量配合 AAD 使用。nonce 只有 96 位，同一个 key 加密的不同明文数量不应该超过 2^32 量级，
+	// 否则 nonce 碰撞的概率会变得不可忽略，而 GCM 中 nonce 碰撞会破坏加密的安全性
+	// 解密不依赖该字段，确定性加密和随机加密的密文都可以正常 Scan
+	Deterministic bool
+	// FieldName 列名或者字段名，不为空时 Value 和 Scan 返回的 error 会带上该名字
+	FieldName string
+	// OnEncrypt 每次 Value 结束之后调用，可以用来统计加密的耗时和数据大小
+	// bytesIn 是序列化后的明文长度，bytesOut 是密文长度
+	OnEncrypt func(bytesIn, bytesOut int, dur time.Duration, err error)
+	// OnDecrypt 每次 Scan 结束之后调用
+	// bytesIn 是密文长度，bytesOut 是解密后的明文长度
+	OnDecrypt func(bytesIn, bytesOut int, dur time.Duration, err error)
+	// cache 缓存的 AEAD，由 WithAEADCache 或者 EncryptColumnFactory 设置，AEAD 在第一次使用的时候才构造
+	// 为 nil 的时候每次加解密都重新构造；使用指针是为了让复制出来的 EncryptColumn 共享同一个缓存
+	cache *aeadCache
 }
 
 // 错误定义
@@ -29,23 +72,81 @@ var (
 	errKeyLengthInvalid = errors.New("ekit EncryptColumn仅支持 16/24/32 byte 的key")
 )
 
+// WithFieldName 设置字段名，返回设置后的副本
+// 批量插入、多列加密失败的时候，可以从 error 中直接看出是哪一列出了问题
+func (e EncryptColumn[T]) WithFieldName(name string) EncryptColumn[T] {
+	e.FieldName = name
+	return e
+}
+
+// WithAAD 设置附加认证数据，返回设置后的副本
+func (e EncryptColumn[T]) WithAAD(aad []byte) EncryptColumn[T] {
+	e.AAD = aad
+	return e
+}
+
+// WithAEADCache 返回带有 AEAD 缓存的副本
+// 副本以及从副本复制出来的 EncryptColumn 共享同一个缓存，AEAD 在第一次 Value 或者 Scan 的时候构造，
+// 之后不再为每一行重新构造。批量插入的时候可以先创建一个模板，再为每一行复制模板并设置 Val：
+//
+//	tmpl := EncryptColumn[string]{Key: key}.WithAEADCache()
+//	for _, v := range vals {
+//		col := tmpl
+//		col.Val, col.Valid = v, true
+//	}
+//
+// 缓存只对应第一次使用的算法和密钥，其它的算法和密钥依旧每次重新构造，因此不会累积轮换掉的旧密钥
+func (e EncryptColumn[T]) WithAEADCache() EncryptColumn[T] {
+	e.cache = &aeadCache{}
+	return e
+}
+
 // Value 实现driver.Valuer接口，将值加密后存入数据库。
 // 返回值可能为[]byte类型（加密后的数据）或错误。
 // 如果 T 是基本类型，那么会对 T 进行直接加密
-// 否则，将 T 按照 JSON 序列化之后进行加密，返回加密后的数据
+// 如果 T 是 time.Time，那么使用 MarshalBinary 序列化，时间点和时区偏移都会被完整保留，
+// 但是时区的名字和单调时钟读数不会被保留；旧版本按照 JSON 存储的 time.Time 依旧可以正常 Scan
+// 否则，如果为 T 注册了 ColumnCodec，使用它进行序列化，
+// 没有注册则将 T 按照 JSON 序列化之后进行加密，返回加密后的数据
+// 如果 T 是指针、切片、map 之类的类型，并且 Val 为 nil，那么会直接存储 NULL
 func (e EncryptColumn[T]) Value() (driver.Value, error) {
+	var start time.Time
+	if e.OnEncrypt != nil {
+		start = time.Now()
+	}
+	res, bytesIn, err := e.value()
+	if err != nil && e.FieldName != "" {
+		res, err = nil, fmt.Errorf("encrypting field %q: %w", e.FieldName, err)
+	}
+	if e.OnEncrypt != nil {
+		encrypted, _ := res.([]byte)
+		e.OnEncrypt(bytesIn, len(encrypted), time.Since(start), err)
+	}
+	return res, err
+}
+
+// value 返回加密结果以及序列化后的明文长度
+func (e EncryptColumn[T]) value() (driver.Value, int, error) {
 	//检查值有效性
 	if !e.Valid {
-		return nil, errInvalid
+		return nil, 0, errInvalid
 	}
-	// 验证密钥长度
-	if len(e.Key) != 16 && len(e.Key) != 24 && len(e.Key) != 32 {
-		return nil, errKeyLengthInvalid
+	// nil 直接存储为 NULL，而不是加密 JSON 的 null
+	if isNil(e.Val) {
+		return nil, 0, nil
+	}
+	// 获取 AEAD 的时候会验证密钥长度
+	key, err := e.currentKey()
+	if err != nil {
+		return nil, 0, err
+	}
+	aead, err := e.getAEAD(e.Suite, key)
+	if err != nil {
+		return nil, 0, err
 	}
 	var (
 		val any = e.Val // 将值转为interface{}以进行类型断言
 		b   []byte
-		err error
 	)
 
 	// 根据值的实际类型进行序列化处理
@@ -70,39 +171,189 @@ func (e EncryptColumn[T]) Value() (driver.Value, error) {
 		buffer := new(bytes.Buffer)
 		err = binary.Write(buffer, binary.BigEndian, tmp)
 		b = buffer.Bytes()
-	default: // 其他类型使用JSON序列化
-		b, err = json.Marshal(e.Val)
+	case time.Time: // 使用 MarshalBinary，保留精确的时间点以及时区偏移
+		b, err = valT.MarshalBinary()
+	default: // 其他类型优先使用注册的 ColumnCodec，否则使用JSON序列化
+		if codec, ok := columnCodecOf[T](); ok {
+			b, err = codec.Encode(e.Val)
+		} else {
+			b, err = json.Marshal(e.Val)
+		}
 	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	//对序列化后的数据进行加密
+	res, err := e.encrypt(aead, key, b)
+	return res, len(b), err
+}
+
+// Algorithm 返回当前配置的加密算法，例如 "AES-256-GCM"、"ChaCha20-Poly1305"
+// 密钥长度不合法或者算法未知时返回空字符串
+// 主要用于审计，确认敏感字段使用了预期强度的加密算法
+// 注意：没有设置 Keys 而设置了 KeyProvider 的时候，需要调用 KeyProvider.Key 获取密钥才能得知密钥长度，
+// 这可能是一次访问 KMS 的远程调用；只需要算法名字的时候可以直接使用 Suite.String()
+func (e EncryptColumn[T]) Algorithm() string {
+	bits := e.KeyBits()
+	if bits == 0 {
+		return ""
+	}
+	if e.Suite == CipherSuiteChaCha20Poly1305 {
+		return e.Suite.String()
+	}
+	return fmt.Sprintf("AES-%d-GCM", bits)
+}
+
+// KeyBits 返回加密使用的密钥的位数，AES-GCM 为 128/192/256，ChaCha20-Poly1305 为 256
+// 密钥长度不合法或者算法未知时返回 0
+// 和 Algorithm 一样，可能会调用 KeyProvider.Key
+func (e EncryptColumn[T]) KeyBits() int {
+	key, err := e.currentKey()
+	if err != nil {
+		return 0
+	}
+	switch e.Suite {
+	case CipherSuiteAESGCM:
+		switch len(key) {
+		case 16, 24, 32:
+			return len(key) * 8
+		}
+	case CipherSuiteChaCha20Poly1305:
+		if len(key) == 32 {
+			return 256
+		}
+	}
+	return 0
+}
+
+// currentKey 返回加密使用的密钥：设置了 Keys 的时候是 Keys[KeyVersion]，否则是 baseKey
+func (e EncryptColumn[T]) currentKey() (string, error) {
+	if e.Keys == nil {
+		return e.baseKey()
+	}
+	key, ok := e.Keys[e.KeyVersion]
+	if !ok {
+		return "", newErrUnknownKeyVersion(e.KeyVersion)
+	}
+	return key, nil
+}
+
+// baseKey 返回没有密钥版本时使用的密钥：设置了 KeyProvider 的时候从中获取，否则是 Key
+func (e EncryptColumn[T]) baseKey() (string, error) {
+	if e.KeyProvider == nil {
+		return e.Key, nil
+	}
+	key, err := e.KeyProvider.Key(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("ekit EncryptColumn 获取key失败: %w", err)
 	}
-	//对序列化后的数据进行AES-GCM加密
-	return e.aesEncrypt(b)
+	return string(key), nil
 }
 
 // Scan 实现sql.Scanner接口，从数据库读取并解密数据。
 // 参数src为数据库读取的原始数据（[]byte或string类型）。
 // 并将解密后的数据进行反序列化，构造 T
+// 如果 T 是指针、切片、map 之类的类型，那么 NULL 会被解析为 nil，并且 Valid 为 true
 func (e *EncryptColumn[T]) Scan(src any) error {
+	var start time.Time
+	if e.OnDecrypt != nil {
+		start = time.Now()
+	}
+	bytesOut, err := e.scan(src)
+	if err != nil && e.FieldName != "" {
+		err = fmt.Errorf("decrypting field %q: %w", e.FieldName, err)
+	}
+	if e.OnDecrypt != nil {
+		var bytesIn int
+		switch value := src.(type) {
+		case []byte:
+			bytesIn = len(value)
+		case string:
+			bytesIn = len(value)
+		}
+		e.OnDecrypt(bytesIn, bytesOut, time.Since(start), err)
+	}
+	return err
+}
+
+// scan 返回解密后的明文长度
+func (e *EncryptColumn[T]) scan(src any) (int, error) {
 	var (
 		b   []byte
 		err error
 	)
 	// 根据数据库返回类型转换数据
 	switch value := src.(type) {
+	case nil:
+		if !isNilable(e.Val) {
+			return 0, fmt.Errorf("ekit：EncryptColumn.Scan 不支持 src 类型 %v", src)
+		}
+		var zero T
+		e.Val = zero
+		e.Valid = true
+		return 0, nil
 	case []byte:
-		b, err = e.aesDecrypt(value)
+		b, err = e.decrypt(value)
 	case string:
-		b, err = e.aesDecrypt([]byte(value))
+		b, err = e.decrypt([]byte(value))
 	default:
-		return fmt.Errorf("ekit：EncryptColumn.Scan 不支持 src 类型 %v", src)
+		return 0, fmt.Errorf("ekit：EncryptColumn.Scan 不支持 src 类型 %v", src)
 	}
 	if err != nil {
-		return err
+		return 0, err
 	}
 	// 解密后反序列化到目标类型
 	err = e.setValAfterDecrypt(b)
 	e.Valid = err == nil // 根据反序列化结果设置有效性标志
+	return len(b), err
+}
+
+// ScanMultiKey 依次使用 keys 中的每一个 key 尝试解密 src，直到某一个 key 解密成功
+// 适用于密钥轮换期间，数据库中同时存在用新旧密钥加密的数据的场景
+// 解密成功之后只会更新 dst 的 Val 和 Valid，dst.Key 保持不变
+// 所有 key 都失败的时候返回由每一个 key 的错误组合而成的 error，dst 保持不变
+// keys 为空的时候等价于 dst.Scan(src)
+func ScanMultiKey[T any](dst *EncryptColumn[T], src any, keys ...string) error {
+	if len(keys) == 0 {
+		return dst.Scan(src)
+	}
+	var start time.Time
+	if dst.OnDecrypt != nil {
+		start = time.Now()
+	}
+	var (
+		bytesOut int
+		err      error
+	)
+	errList := make([]error, 0, len(keys))
+	for i, key := range keys {
+		attempt := *dst
+		attempt.Key = key
+		attempt.KeyProvider = nil
+		bytesOut, err = attempt.scan(src)
+		if err == nil {
+			dst.Val, dst.Valid = attempt.Val, attempt.Valid
+			break
+		}
+		errList = append(errList, fmt.Errorf("key #%d: %w", i, err))
+	}
+	if err != nil {
+		bytesOut = 0
+		err = fmt.Errorf("ekit：EncryptColumn 所有的 key 都解密失败: %w", errors.Join(errList...))
+		if dst.FieldName != "" {
+			err = fmt.Errorf("decrypting field %q: %w", dst.FieldName, err)
+		}
+	}
+	if dst.OnDecrypt != nil {
+		var bytesIn int
+		switch value := src.(type) {
+		case []byte:
+			bytesIn = len(value)
+		case string:
+			bytesIn = len(value)
+		}
+		dst.OnDecrypt(bytesIn, bytesOut, time.Since(start), err)
+	}
 	return err
 }
 
@@ -130,46 +381,180 @@ func (e *EncryptColumn[T]) setValAfterDecrypt(deEncrypt []byte) error {
 		reader := bytes.NewReader(deEncrypt)
 		err = binary.Read(reader, binary.BigEndian, tmp)
 		*valT = uint(*tmp)
-	default: // 其他类型使用JSON反序列化
-		err = json.Unmarshal(deEncrypt, &e.Val)
+	case *time.Time:
+		// 旧版本按照 JSON 存储 time.Time，以 " 开头；MarshalBinary 的结果以版本号开头，不会是 "
+		if len(deEncrypt) > 0 && deEncrypt[0] == '"' {
+			err = json.Unmarshal(deEncrypt, valT)
+		} else if err = valT.UnmarshalBinary(deEncrypt); err != nil {
+			if jsonErr := json.Unmarshal(deEncrypt, valT); jsonErr == nil {
+				err = nil
+			}
+		}
+	default: // 其他类型优先使用注册的 ColumnCodec，否则使用JSON反序列化
+		if codec, ok := columnCodecOf[T](); ok {
+			e.Val, err = codec.Decode(deEncrypt)
+		} else {
+			err = json.Unmarshal(deEncrypt, &e.Val)
+		}
 	}
 	return err
 }
 
-// aesEncrypt 使用AES-GCM模式加密数据，返回nonce和密文的组合。
-func (e *EncryptColumn[T]) aesEncrypt(data []byte) ([]byte, error) {
-	// 创建AES cipher实例
-	block, err := aes.NewCipher([]byte(e.Key))
-	if err != nil {
-		return nil, err
+// isNilable 判断 T 是否可以为 nil
+func isNilable[T any](val T) bool {
+	typ := reflect.TypeOf(&val).Elem()
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return true
+	default:
+		return false
 	}
-	// 创建GCM模式实例
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return nil, err
+}
+
+// isNil 判断 val 是否是 nil 的指针、切片、map 或者接口
+func isNil[T any](val T) bool {
+	if !isNilable(val) {
+		return false
 	}
-	// 生成随机nonce
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
-		return nil, err
+	return reflect.ValueOf(&val).Elem().IsNil()
+}
+
+// encrypt 使用 aead 加密数据，返回nonce和密文的组合。
+// Deterministic 为 true 的时候 nonce 由 key、AAD 和明文派生，否则是随机生成的
+// 设置了 Keys 的时候会在最前面加上三个字节的头部：keyedHeaderMagic、Suite 和 KeyVersion；
+// 否则非默认算法会在最前面加上两个字节的头部：cipherSuiteMagic 和 Suite
+func (e *EncryptColumn[T]) encrypt(aead cipher.AEAD, key string, data []byte) ([]byte, error) {
+	var nonce []byte
+	if e.Deterministic {
+		nonce = deriveDeterministicNonce(key, e.AAD, data, aead.NonceSize())
+	} else {
+		// 生成随机nonce
+		nonce = make([]byte, aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+	}
+	var dst []byte
+	switch {
+	case e.Keys != nil:
+		dst = make([]byte, 0, 3+len(nonce)+len(data)+aead.Overhead())
+		dst = append(dst, keyedHeaderMagic, byte(e.Suite), e.KeyVersion)
+	case e.Suite != CipherSuiteAESGCM:
+		dst = make([]byte, 0, 2+len(nonce)+len(data)+aead.Overhead())
+		dst = append(dst, cipherSuiteMagic, byte(e.Suite))
 	}
+	dst = append(dst, nonce...)
 	// 加密并组合nonce和密文
-	return gcm.Seal(nonce, nonce, data, nil), nil
+	return aead.Seal(dst, nonce, data, e.AAD), nil
+}
+
+// decrypt 按照密文头部记录的密钥版本和算法解密数据
+// 没有密钥版本头部的旧密文使用 Key 解密
+func (e *EncryptColumn[T]) decrypt(data []byte) ([]byte, error) {
+	suite, version, body, ok := parseKeyedHeader(data)
+	if !ok {
+		return e.decryptWithKey(data)
+	}
+	key, found := e.Keys[version]
+	var err error
+	if found {
+		var res []byte
+		if res, err = e.open(suite, key, body); err == nil {
+			return res, nil
+		}
+	} else {
+		err = newErrUnknownKeyVersion(version)
+	}
+	// 旧密文的第一个字节可能恰好和头部相同，此时退回旧的格式
+	if legacy, legacyErr := e.decryptWithKey(data); legacyErr == nil {
+		return legacy, nil
+	}
+	return nil, err
 }
 
-// aesDecrypt 使用AES-GCM模式解密数据。
-func (e *EncryptColumn[T]) aesDecrypt(data []byte) ([]byte, error) {
-	block, err := aes.NewCipher([]byte(e.Key))
+// decryptWithKey 使用 baseKey 解密没有密钥版本头部的密文，按照算法头部选择算法，没有算法头部的按照 AES-GCM 解密。
+func (e *EncryptColumn[T]) decryptWithKey(data []byte) ([]byte, error) {
+	key, err := e.baseKey()
 	if err != nil {
 		return nil, err
 	}
-	gcm, err := cipher.NewGCM(block)
+	suite, body, ok := parseCipherSuiteHeader(data)
+	if !ok {
+		return e.open(CipherSuiteAESGCM, key, data)
+	}
+	res, err := e.open(suite, key, body)
+	if err != nil {
+		// 没有头部的旧数据的 nonce 是随机的，可能恰好以头部的字节开头，此时退回 AES-GCM
+		if legacy, legacyErr := e.open(CipherSuiteAESGCM, key, data); legacyErr == nil {
+			return legacy, nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// open 使用指定的算法和密钥解密 nonce 和密文的组合
+func (e *EncryptColumn[T]) open(suite CipherSuite, key string, data []byte) ([]byte, error) {
+	aead, err := e.getAEAD(suite, key)
 	if err != nil {
 		return nil, err
 	}
 	// 分离nonce和密文
-	nonceSize := gcm.NonceSize()
+	nonceSize := aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errCiphertextTooShort
+	}
 	nonce, ciphertext := data[:nonceSize], data[nonceSize:]
 	// 解密数据
-	return gcm.Open(nil, nonce, ciphertext, nil)
+	return aead.Open(nil, nonce, ciphertext, e.AAD)
+}
+
+// getAEAD 返回 suite 和 key 对应的 AEAD
+// cache 命中的时候复用缓存的 AEAD，否则每次都重新构造，
+// 例如没有调用 WithAEADCache，或者密钥轮换期间使用旧密钥解密的数据
+func (e *EncryptColumn[T]) getAEAD(suite CipherSuite, key string) (cipher.AEAD, error) {
+	if e.cache != nil {
+		if aead, ok, err := e.cache.get(suite, key); ok {
+			return aead, err
+		}
+	}
+	return newAEAD(suite, []byte(key))
+}
+
+// aeadCache 延迟构造并缓存 AEAD，构造的时候校验密钥长度
+// 只缓存第一次 get 时使用的 suite 和 key 对应的 AEAD，可以被多个 goroutine 同时使用
+type aeadCache struct {
+	initOnce sync.Once
+	suite    CipherSuite
+	key      string
+	aead     cipher.AEAD
+	initErr  error
+}
+
+// get 返回缓存的 AEAD，第一次调用的时候构造
+// suite 和 key 与缓存的不一致时 ok 为 false
+func (c *aeadCache) get(suite CipherSuite, key string) (aead cipher.AEAD, ok bool, err error) {
+	c.initOnce.Do(func() {
+		c.suite, c.key = suite, key
+		c.aead, c.initErr = newAEAD(suite, []byte(key))
+	})
+	if c.suite != suite || c.key != key {
+		return nil, false, nil
+	}
+	return c.aead, true, c.initErr
+}
+
+// newAESGCM 创建 AES-GCM 模式的 AEAD
+// key 必须为 16/24/32 字节长度
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+		return nil, errKeyLengthInvalid
+	}
+	// 创建AES cipher实例
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	// 创建GCM模式实例
+	return cipher.NewGCM(block)
 }
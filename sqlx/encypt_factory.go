@@ -0,0 +1,41 @@
+package sqlx
+
+// EncryptColumnFactory 用于批量创建使用相同密钥的 EncryptColumn
+// 密钥只会校验一次，AEAD 也只会构造一次，并且被所有创建出来的 EncryptColumn 共享，
+// 相比每一行都直接构造 EncryptColumn，可以省去每次加解密构造 AEAD 的开销，
+// 适合 ORM 之类需要为每一行数据都构造 EncryptColumn 的场景
+type EncryptColumnFactory[T any] struct {
+	key   string
+	cache *aeadCache
+}
+
+// NewEncryptColumnFactory 创建一个 EncryptColumnFactory
+// key 必须为 16/24/32 字节长度
+func NewEncryptColumnFactory[T any](key string) (*EncryptColumnFactory[T], error) {
+	cache := &aeadCache{}
+	if _, _, err := cache.get(CipherSuiteAESGCM, key); err != nil {
+		return nil, err
+	}
+	return &EncryptColumnFactory[T]{
+		key:   key,
+		cache: cache,
+	}, nil
+}
+
+// New 创建一个有效的 EncryptColumn，一般用于写入数据
+func (f *EncryptColumnFactory[T]) New(val T) EncryptColumn[T] {
+	return EncryptColumn[T]{
+		Val:   val,
+		Valid: true,
+		Key:   f.key,
+		cache: f.cache,
+	}
+}
+
+// NewPtr 创建一个空的 EncryptColumn 指针，一般用于 Scan 读取数据
+func (f *EncryptColumnFactory[T]) NewPtr() *EncryptColumn[T] {
+	return &EncryptColumn[T]{
+		Key:   f.key,
+		cache: f.cache,
+	}
+}
@@ -0,0 +1,82 @@
+package sqlx
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEncryptColumnFactory(t *testing.T) {
+	_, err := NewEncryptColumnFactory[string]("ABC")
+	assert.Equal(t, errKeyLengthInvalid, err)
+
+	f, err := NewEncryptColumnFactory[Simple]("ABCDABCDABCDABCD")
+	require.NoError(t, err)
+	for i := 0; i < 1000; i++ {
+		col := f.New(Simple{Name: "Tom", Age: i})
+		assert.Same(t, f.cache, col.cache)
+		val, err := col.Value()
+		require.NoError(t, err)
+
+		res := f.NewPtr()
+		assert.Same(t, f.cache, res.cache)
+		err = res.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, res.Valid)
+		assert.Equal(t, col.Val, res.Val)
+	}
+
+	// 和直接使用 Key 的 EncryptColumn 相互兼容
+	val, err := f.New(Simple{Name: "Jerry"}).Value()
+	require.NoError(t, err)
+	res := &EncryptColumn[Simple]{Key: "ABCDABCDABCDABCD"}
+	err = res.Scan(val)
+	require.NoError(t, err)
+	assert.Equal(t, Simple{Name: "Jerry"}, res.Val)
+}
+
+func TestEncryptColumnFactory_Concurrent(t *testing.T) {
+	f, err := NewEncryptColumnFactory[string]("ABCDABCDABCDABCD")
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			val, err := f.New(strconv.Itoa(i)).Value()
+			assert.NoError(t, err)
+			res := f.NewPtr()
+			assert.NoError(t, res.Scan(val))
+			assert.Equal(t, strconv.Itoa(i), res.Val)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// BenchmarkEncryptColumnFactory 模拟批量插入 10000 行数据
+// 直接构造 EncryptColumn 的时候每一行都要重新构造 AEAD，使用 EncryptColumnFactory 则只构造一次
+func BenchmarkEncryptColumnFactory(b *testing.B) {
+	const rows = 10000
+	key := "ABCDABCDABCDABCD"
+	b.Run("new column per row", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < rows; j++ {
+				_, _ = EncryptColumn[string]{Key: key, Val: "hello", Valid: true}.Value()
+			}
+		}
+	})
+	b.Run("factory", func(b *testing.B) {
+		b.ReportAllocs()
+		f, err := NewEncryptColumnFactory[string](key)
+		require.NoError(b, err)
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < rows; j++ {
+				_, _ = f.New("hello").Value()
+			}
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestEncryptColumn_Basic(t *testing.T) {
@@ -64,6 +65,322 @@ func TestEncryptColumn_Basic(t *testing.T) {
 	}
 }
 
+func TestEncryptColumn_Algorithm(t *testing.T) {
+	testCases := []struct {
+		name     string
+		key      string
+		suite    CipherSuite
+		wantAlg  string
+		wantBits int
+	}{
+		{
+			name:     "16 bytes",
+			key:      "ABCDABCDABCDABCD",
+			wantAlg:  "AES-128-GCM",
+			wantBits: 128,
+		},
+		{
+			name:     "24 bytes",
+			key:      "ABCDABCDABCDABCDABCDABCD",
+			wantAlg:  "AES-192-GCM",
+			wantBits: 192,
+		},
+		{
+			name:     "32 bytes",
+			key:      "ABCDABCDABCDABCDABCDABCDABCDABCD",
+			wantAlg:  "AES-256-GCM",
+			wantBits: 256,
+		},
+		{
+			name: "wrong length key",
+			key:  "ABC",
+		},
+		{
+			name:     "chacha20 poly1305",
+			key:      "ABCDABCDABCDABCDABCDABCDABCDABCD",
+			suite:    CipherSuiteChaCha20Poly1305,
+			wantAlg:  "ChaCha20-Poly1305",
+			wantBits: 256,
+		},
+		{
+			name:  "chacha20 poly1305 wrong length key",
+			key:   "ABCDABCDABCDABCD",
+			suite: CipherSuiteChaCha20Poly1305,
+		},
+		{
+			name:  "unknown suite",
+			key:   "ABCDABCDABCDABCD",
+			suite: CipherSuite(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := EncryptColumn[string]{Key: tc.key, Suite: tc.suite}
+			assert.Equal(t, tc.wantAlg, e.Algorithm())
+			assert.Equal(t, tc.wantBits, e.KeyBits())
+		})
+	}
+}
+
+func TestEncryptColumn_FieldName(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	_, err := EncryptColumn[string]{Key: "ABC", Val: "abc", Valid: true}.WithFieldName("email").Value()
+	assert.ErrorIs(t, err, errKeyLengthInvalid)
+	assert.Contains(t, err.Error(), `encrypting field "email"`)
+
+	_, err = EncryptColumn[string]{Key: key}.WithFieldName("phone").Value()
+	assert.ErrorIs(t, err, errInvalid)
+	assert.Contains(t, err.Error(), `encrypting field "phone"`)
+
+	col := EncryptColumn[string]{Key: key}.WithFieldName("email")
+	err = col.Scan(123)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `decrypting field "email"`)
+
+	// 没有设置字段名的时候保持原样
+	_, err = EncryptColumn[string]{Key: "ABC", Val: "abc", Valid: true}.Value()
+	assert.Equal(t, errKeyLengthInvalid, err)
+}
+
+func TestEncryptColumn_Nil(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	// nil 指针存储为 NULL
+	val, err := EncryptColumn[*Simple]{Key: key, Valid: true}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, val)
+
+	res := &EncryptColumn[*Simple]{Key: key, Val: &Simple{Name: "Tom"}}
+	err = res.Scan(val)
+	require.NoError(t, err)
+	assert.True(t, res.Valid)
+	assert.Nil(t, res.Val)
+
+	// 非 nil 指针正常加密
+	val, err = EncryptColumn[*Simple]{Key: key, Valid: true, Val: &Simple{Name: "Tom", Age: 18}}.Value()
+	require.NoError(t, err)
+	assert.NotNil(t, val)
+	err = res.Scan(val)
+	require.NoError(t, err)
+	assert.Equal(t, &Simple{Name: "Tom", Age: 18}, res.Val)
+
+	// nil map 和 nil slice
+	val, err = EncryptColumn[map[string]string]{Key: key, Valid: true}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, val)
+	val, err = EncryptColumn[[]string]{Key: key, Valid: true}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, val)
+
+	// 不能为 nil 的类型依旧返回错误
+	err = (&EncryptColumn[int]{Key: key}).Scan(nil)
+	assert.Error(t, err)
+}
+
+func TestEncryptColumn_Hooks(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	var (
+		in, out int
+		hookErr error
+		called  int
+	)
+	hook := func(bytesIn, bytesOut int, dur time.Duration, err error) {
+		in, out, hookErr = bytesIn, bytesOut, err
+		assert.True(t, dur >= 0)
+		called++
+	}
+
+	// nonce 12 字节，认证标签 16 字节
+	val, err := EncryptColumn[string]{Key: key, Val: "hello", Valid: true, OnEncrypt: hook}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, 1, called)
+	assert.Equal(t, 5, in)
+	assert.Equal(t, 5+12+16, out)
+	assert.NoError(t, hookErr)
+
+	col := &EncryptColumn[string]{Key: key, OnDecrypt: hook}
+	err = col.Scan(val)
+	require.NoError(t, err)
+	assert.Equal(t, 2, called)
+	assert.Equal(t, 5+12+16, in)
+	assert.Equal(t, 5, out)
+	assert.NoError(t, hookErr)
+
+	// 解密失败
+	col = &EncryptColumn[string]{Key: "BCDABCDABCDABCDA", OnDecrypt: hook}
+	err = col.Scan(val)
+	assert.Error(t, err)
+	assert.Equal(t, 3, called)
+	assert.Equal(t, 5+12+16, in)
+	assert.Equal(t, 0, out)
+	assert.Equal(t, err, hookErr)
+
+	// 加密失败
+	_, err = EncryptColumn[string]{Key: "ABC", Val: "hello", Valid: true, OnEncrypt: hook}.Value()
+	assert.Error(t, err)
+	assert.Equal(t, 4, called)
+	assert.Equal(t, err, hookErr)
+}
+
+func TestEncryptColumn_Time(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	testCases := []struct {
+		name string
+		val  time.Time
+	}{
+		{
+			name: "utc",
+			val:  time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC),
+		},
+		{
+			name: "non utc zone",
+			val:  time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.FixedZone("CST", 8*3600)),
+		},
+		{
+			name: "negative offset",
+			val:  time.Date(1999, 12, 31, 23, 59, 59, 1, time.FixedZone("EST", -5*3600)),
+		},
+		{
+			name: "with monotonic clock",
+			val:  time.Now(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := EncryptColumn[time.Time]{Key: key, Val: tc.val, Valid: true}.Value()
+			require.NoError(t, err)
+			col := &EncryptColumn[time.Time]{Key: key}
+			err = col.Scan(val)
+			require.NoError(t, err)
+			assert.True(t, col.Valid)
+			// 时间点完全一致
+			assert.True(t, tc.val.Equal(col.Val))
+			assert.Equal(t, tc.val.UnixNano(), col.Val.UnixNano())
+			// 时区偏移一致
+			_, wantOffset := tc.val.Zone()
+			_, offset := col.Val.Zone()
+			assert.Equal(t, wantOffset, offset)
+			assert.Equal(t, tc.val.Format(time.RFC3339Nano), col.Val.Format(time.RFC3339Nano))
+		})
+	}
+}
+
+func TestEncryptColumn_LegacyJSONTime(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	// 旧版本将 time.Time 按照 JSON 序列化之后加密
+	plain, err := json.Marshal(want)
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-01-02T03:04:05Z"`, string(plain))
+	aead, err := newAESGCM([]byte(key))
+	require.NoError(t, err)
+	nonce := make([]byte, aead.NonceSize())
+	encrypted := aead.Seal(nonce, nonce, plain, nil)
+
+	col := &EncryptColumn[time.Time]{Key: key}
+	require.NoError(t, col.Scan(encrypted))
+	assert.True(t, col.Valid)
+	assert.True(t, want.Equal(col.Val))
+}
+
+func TestEncryptColumn_AAD(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	val, err := EncryptColumn[string]{Key: key, Val: "hello", Valid: true}.WithAAD([]byte("user:1")).Value()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		aad     []byte
+		wantErr bool
+	}{
+		{
+			name: "same aad",
+			aad:  []byte("user:1"),
+		},
+		{
+			name:    "copied to another row",
+			aad:     []byte("user:2"),
+			wantErr: true,
+		},
+		{
+			name:    "missing aad",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			col := &EncryptColumn[string]{Key: key, AAD: tc.aad}
+			err := col.Scan(val)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.False(t, col.Valid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "hello", col.Val)
+		})
+	}
+}
+
+func TestScanMultiKey(t *testing.T) {
+	oldKey, newKey := "ABCDABCDABCDABCD", "BCDABCDABCDABCDA"
+	val, err := EncryptColumn[string]{Key: oldKey, Val: "hello", Valid: true}.Value()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		keys    []string
+		wantVal string
+		wantErr bool
+	}{
+		{
+			name:    "first key",
+			keys:    []string{oldKey, newKey},
+			wantVal: "hello",
+		},
+		{
+			name:    "second key",
+			keys:    []string{newKey, oldKey},
+			wantVal: "hello",
+		},
+		{
+			name:    "all keys failed",
+			keys:    []string{newKey, "CDABCDABCDABCDAB"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			col := &EncryptColumn[string]{Key: newKey}
+			err := ScanMultiKey(col, val, tc.keys...)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.False(t, col.Valid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantVal, col.Val)
+			assert.True(t, col.Valid)
+			// 原本的 Key 保持不变
+			assert.Equal(t, newKey, col.Key)
+		})
+	}
+}
+
+func TestScanMultiKey_FieldName(t *testing.T) {
+	val, err := EncryptColumn[string]{Key: "ABCDABCDABCDABCD", Val: "hello", Valid: true}.Value()
+	require.NoError(t, err)
+	col := &EncryptColumn[string]{FieldName: "phone"}
+	err = ScanMultiKey(col, val, "BCDABCDABCDABCDA", "ABC")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `decrypting field "phone"`)
+	assert.Contains(t, err.Error(), "key #0")
+	assert.Contains(t, err.Error(), "key #1")
+}
+
 func TestEncryptColumn_Sql(t *testing.T) {
 	db, err := sql.Open("sqlite3", "file:test.db?cache=shared&mode=memory")
 	if err != nil {
@@ -225,3 +542,64 @@ type Simple struct {
 	Name string
 	Age  int
 }
+
+func TestEncryptColumn_WithAEADCache(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	tmpl := EncryptColumn[string]{Key: key}.WithAEADCache()
+	first, second := tmpl, tmpl
+	first.Val, first.Valid = "hello", true
+	val, err := first.Value()
+	require.NoError(t, err)
+
+	// 复制出来的 EncryptColumn 共享同一个缓存
+	assert.Same(t, first.cache, second.cache)
+	require.NoError(t, second.Scan(val))
+	assert.Equal(t, "hello", second.Val)
+	aead, ok, err := tmpl.cache.get(CipherSuiteAESGCM, key)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	cached, err := second.getAEAD(CipherSuiteAESGCM, key)
+	require.NoError(t, err)
+	assert.Same(t, aead, cached)
+
+	// 其它的密钥不使用缓存，但是依旧可以正常加解密
+	other := tmpl
+	other.Key, other.Val, other.Valid = "BCDABCDABCDABCDA", "world", true
+	val, err = other.Value()
+	require.NoError(t, err)
+	_, ok, _ = tmpl.cache.get(CipherSuiteAESGCM, other.Key)
+	assert.False(t, ok)
+	res := &EncryptColumn[string]{Key: other.Key}
+	require.NoError(t, res.Scan(val))
+	assert.Equal(t, "world", res.Val)
+
+	// 密钥长度在构造 AEAD 的时候校验
+	_, err = EncryptColumn[string]{Key: "ABC", Val: "hello", Valid: true}.WithAEADCache().Value()
+	assert.Equal(t, errKeyLengthInvalid, err)
+}
+
+// BenchmarkEncryptColumn_Value 模拟批量插入 10000 行数据
+// 没有缓存的时候每一行都要重新构造 AEAD，WithAEADCache 则只构造一次
+func BenchmarkEncryptColumn_Value(b *testing.B) {
+	const rows = 10000
+	key := "ABCDABCDABCDABCD"
+	b.Run("without cache", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < rows; j++ {
+				_, _ = EncryptColumn[string]{Key: key, Val: "hello", Valid: true}.Value()
+			}
+		}
+	})
+	b.Run("with cache", func(b *testing.B) {
+		b.ReportAllocs()
+		tmpl := EncryptColumn[string]{Key: key}.WithAEADCache()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < rows; j++ {
+				col := tmpl
+				col.Val, col.Valid = "hello", true
+				_, _ = col.Value()
+			}
+		}
+	})
+}
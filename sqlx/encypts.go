@@ -1,11 +1,14 @@
 package sqlx
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/vmihailenco/msgpack/v5"
@@ -19,25 +22,71 @@ type SecureField[T any] struct {
 	isValid   bool
 	secret    []byte
 	aead      cipher.AEAD
+	newAEAD   func(secret []byte) (cipher.AEAD, error)
 	initOnce  sync.Once
 	initError error
+	closed    bool
+}
+
+// errSecureFieldClosed 代表 SecureField 已经被 Close
+var errSecureFieldClosed = errors.New("安全字段已关闭")
+
+// SecureFieldOption SecureField 的可选配置
+type SecureFieldOption func(opts *secureFieldOptions)
+
+type secureFieldOptions struct {
+	newAEAD  func(secret []byte) (cipher.AEAD, error)
+	selfTest bool
+}
+
+// WithAEADFactory 使用自定义的 AEAD 构造函数代替默认的 AES-GCM
+// 使用自定义构造函数时，密钥长度由构造函数自己校验
+func WithAEADFactory(factory func(secret []byte) (cipher.AEAD, error)) SecureFieldOption {
+	return func(opts *secureFieldOptions) {
+		opts.newAEAD = factory
+	}
+}
+
+// WithSelfTest 在创建的时候执行一次 Verify
+// 自检失败的时候，后续的 Value 和 Scan 都会返回该错误
+func WithSelfTest() SecureFieldOption {
+	return func(opts *secureFieldOptions) {
+		opts.selfTest = true
+	}
 }
 
 // NewSecureField 创建新的安全字段实例
-func NewSecureField[T any](secret []byte, initialValue T) *SecureField[T] {
+func NewSecureField[T any](secret []byte, initialValue T, opts ...SecureFieldOption) *SecureField[T] {
+	var o secureFieldOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	sf := &SecureField[T]{
 		value:   initialValue,
 		isValid: true,
 		secret:  make([]byte, len(secret)),
+		newAEAD: o.newAEAD,
 	}
 	copy(sf.secret, secret)
 	sf.setupCrypto()
+	if o.selfTest && sf.initError == nil {
+		sf.initError = sf.Verify()
+	}
 	return sf
 }
 
 // 初始化加密组件
 func (sf *SecureField[T]) setupCrypto() {
 	sf.initOnce.Do(func() {
+		if sf.newAEAD != nil {
+			var err error
+			sf.aead, err = sf.newAEAD(sf.secret)
+			if err != nil {
+				sf.initError = fmt.Errorf("加密模式创建失败: %w", err)
+			}
+			return
+		}
+
 		if len(sf.secret) != 16 && len(sf.secret) != 24 && len(sf.secret) != 32 {
 			sf.initError = errors.New("安全密钥长度必须为16/24/32字节")
 			return
@@ -56,8 +105,46 @@ func (sf *SecureField[T]) setupCrypto() {
 	})
 }
 
+// selfTestVector 自检使用的固定明文
+var selfTestVector = []byte("ekit SecureField self-test vector")
+
+// Verify 使用当前的密钥和 AEAD 对固定的测试数据执行一次加密再解密
+// 解密失败或者结果和原文不一致时返回错误
+// 可以在启动的时候对每一个配置的密钥执行一次，尽早发现配置问题
+func (sf *SecureField[T]) Verify() error {
+	if sf.closed {
+		return errSecureFieldClosed
+	}
+	sf.setupCrypto()
+	if sf.initError != nil {
+		return sf.initError
+	}
+	nonce := make([]byte, sf.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("随机数生成失败: %w", err)
+	}
+	encrypted := sf.aead.Seal(nil, nonce, selfTestVector, nil)
+	plainData, err := sf.aead.Open(nil, nonce, encrypted, nil)
+	if err != nil {
+		return fmt.Errorf("加密自检失败: %w", err)
+	}
+	if !bytes.Equal(plainData, selfTestVector) {
+		return errors.New("加密自检失败: 解密结果与原文不一致")
+	}
+	return nil
+}
+
+// Get 返回当前的明文值
+func (sf *SecureField[T]) Get() T {
+	return sf.value
+}
+
 // Value 实现driver.Valuer接口
 func (sf *SecureField[T]) Value() (driver.Value, error) {
+	if sf.closed {
+		return nil, errSecureFieldClosed
+	}
+	sf.setupCrypto()
 	if sf.initError != nil {
 		return nil, sf.initError
 	}
@@ -83,7 +170,11 @@ func (sf *SecureField[T]) Value() (driver.Value, error) {
 		binary.BigEndian.PutUint16(data, uint16(v))
 	// 其他数值类型处理...
 	default:
-		data, err = msgpack.Marshal(sf.value)
+		if codec, ok := columnCodecOf[T](); ok {
+			data, err = codec.Encode(sf.value)
+		} else {
+			data, err = msgpack.Marshal(sf.value)
+		}
 	}
 
 	if err != nil {
@@ -100,6 +191,10 @@ func (sf *SecureField[T]) Value() (driver.Value, error) {
 
 // Scan 实现sql.Scanner接口
 func (sf *SecureField[T]) Scan(src any) error {
+	if sf.closed {
+		return errSecureFieldClosed
+	}
+	sf.setupCrypto()
 	if sf.initError != nil {
 		return sf.initError
 	}
@@ -148,7 +243,14 @@ func (sf *SecureField[T]) Scan(src any) error {
 		*v = int16(binary.BigEndian.Uint16(plainData))
 	// 其他数值类型处理...
 	default:
-		if err := msgpack.Unmarshal(plainData, &sf.value); err != nil {
+		if codec, ok := columnCodecOf[T](); ok {
+			val, err := codec.Decode(plainData)
+			if err != nil {
+				sf.isValid = false
+				return fmt.Errorf("数据反序列化失败: %w", err)
+			}
+			sf.value = val
+		} else if err := msgpack.Unmarshal(plainData, &sf.value); err != nil {
 			sf.isValid = false
 			return fmt.Errorf("数据反序列化失败: %w", err)
 		}
@@ -158,6 +260,61 @@ func (sf *SecureField[T]) Scan(src any) error {
 	return nil
 }
 
+// Close 将内存中的密钥清零，之后该字段不可再用，Value 和 Scan 都会返回错误
+// 适合在请求结束的时候调用，尽早擦除敏感的密钥
+// 注意：AEAD 实现内部可能依旧持有由密钥派生出来的数据，这部分无法由我们清除
+func (sf *SecureField[T]) Close() error {
+	for i := range sf.secret {
+		sf.secret[i] = 0
+	}
+	sf.closed = true
+	sf.aead = nil
+	return nil
+}
+
+// MarshalText 实现encoding.TextMarshaler接口
+// 输出的是密文的 base64 编码，明文不会出现在结果中
+func (sf *SecureField[T]) MarshalText() ([]byte, error) {
+	val, err := sf.Value()
+	if err != nil {
+		return nil, err
+	}
+	encrypted := val.([]byte)
+	res := make([]byte, base64.StdEncoding.EncodedLen(len(encrypted)))
+	base64.StdEncoding.Encode(res, encrypted)
+	return res, nil
+}
+
+// UnmarshalText 实现encoding.TextUnmarshaler接口
+// 输入应该是 MarshalText 输出的 base64 密文
+// 调用前需要通过 NewSecureField 设置好密钥
+func (sf *SecureField[T]) UnmarshalText(text []byte) error {
+	encrypted := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(encrypted, text)
+	if err != nil {
+		return fmt.Errorf("密文解码失败: %w", err)
+	}
+	return sf.Scan(encrypted[:n])
+}
+
+// MarshalJSON 实现json.Marshaler接口，输出 base64 密文组成的 JSON 字符串
+func (sf *SecureField[T]) MarshalJSON() ([]byte, error) {
+	text, err := sf.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON 实现json.Unmarshaler接口
+func (sf *SecureField[T]) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return sf.UnmarshalText([]byte(text))
+}
+
 // 零拷贝转换（需确保数据安全）
 func convertStringToBytes(s string) []byte {
 	return *(*[]byte)(unsafe.Pointer(&s))
@@ -0,0 +1,176 @@
+package sqlx
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecureField_MarshalText(t *testing.T) {
+	secret := []byte("ABCDABCDABCDABCD")
+	sf := NewSecureField(secret, "my-password")
+	text, err := sf.MarshalText()
+	require.NoError(t, err)
+	assert.NotContains(t, string(text), "my-password")
+	_, err = base64.StdEncoding.DecodeString(string(text))
+	assert.NoError(t, err)
+
+	res := NewSecureField(secret, "")
+	err = res.UnmarshalText(text)
+	require.NoError(t, err)
+	assert.Equal(t, "my-password", res.Get())
+
+	err = res.UnmarshalText([]byte("!!!"))
+	assert.Error(t, err)
+
+	// 密钥不对
+	wrong := NewSecureField([]byte("BCDABCDABCDABCDA"), "")
+	err = wrong.UnmarshalText(text)
+	assert.Error(t, err)
+}
+
+func TestSecureField_MarshalJSON(t *testing.T) {
+	type User struct {
+		Name     string
+		Password *SecureField[Simple]
+	}
+	secret := []byte("ABCDABCDABCDABCD")
+	val := Simple{Name: "大明", Age: 18}
+	data, err := json.Marshal(User{Name: "Tom", Password: NewSecureField(secret, val)})
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "大明")
+
+	var m map[string]string
+	require.NoError(t, json.Unmarshal(data, &m))
+	_, err = base64.StdEncoding.DecodeString(m["Password"])
+	assert.NoError(t, err)
+
+	res := User{Password: NewSecureField(secret, Simple{})}
+	err = json.Unmarshal(data, &res)
+	require.NoError(t, err)
+	assert.Equal(t, "Tom", res.Name)
+	assert.Equal(t, val, res.Password.Get())
+
+	// 没有设置密钥
+	res = User{Password: &SecureField[Simple]{}}
+	err = json.Unmarshal(data, &res)
+	assert.Error(t, err)
+}
+
+func TestSecureField_Verify(t *testing.T) {
+	testCases := []struct {
+		name    string
+		secret  []byte
+		opts    []SecureFieldOption
+		wantErr string
+	}{
+		{
+			name:   "valid key",
+			secret: []byte("ABCDABCDABCDABCD"),
+		},
+		{
+			name:    "wrong length key",
+			secret:  []byte("ABC"),
+			wantErr: "安全密钥长度必须为16/24/32字节",
+		},
+		{
+			name:    "factory error",
+			secret:  []byte("ABCDABCDABCDABCD"),
+			opts:    []SecureFieldOption{WithAEADFactory(func(secret []byte) (cipher.AEAD, error) { return nil, errors.New("mock error") })},
+			wantErr: "加密模式创建失败: mock error",
+		},
+		{
+			name:    "buggy open",
+			secret:  []byte("ABCDABCDABCDABCD"),
+			opts:    []SecureFieldOption{WithAEADFactory(newBuggyAEAD(false))},
+			wantErr: "加密自检失败: cipher: message authentication failed",
+		},
+		{
+			name:    "buggy plaintext",
+			secret:  []byte("ABCDABCDABCDABCD"),
+			opts:    []SecureFieldOption{WithAEADFactory(newBuggyAEAD(true))},
+			wantErr: "加密自检失败: 解密结果与原文不一致",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sf := NewSecureField(tc.secret, "abc", tc.opts...)
+			err := sf.Verify()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+
+			// 创建的时候自检
+			sf = NewSecureField(tc.secret, "abc", append(tc.opts, WithSelfTest())...)
+			_, err = sf.Value()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// buggyAEAD 模拟有问题的 AEAD 实现
+type buggyAEAD struct {
+	cipher.AEAD
+	// corruptPlain 为 true 时篡改解密结果，否则篡改密文
+	corruptPlain bool
+}
+
+func newBuggyAEAD(corruptPlain bool) func(secret []byte) (cipher.AEAD, error) {
+	return func(secret []byte) (cipher.AEAD, error) {
+		block, err := aes.NewCipher(secret)
+		if err != nil {
+			return nil, err
+		}
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		return &buggyAEAD{AEAD: gcm, corruptPlain: corruptPlain}, nil
+	}
+}
+
+func (b *buggyAEAD) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	res := b.AEAD.Seal(dst, nonce, plaintext, additionalData)
+	if !b.corruptPlain {
+		res[len(res)-1] ^= 0xff
+	}
+	return res
+}
+
+func (b *buggyAEAD) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	res, err := b.AEAD.Open(dst, nonce, ciphertext, additionalData)
+	if err == nil && b.corruptPlain && len(res) > 0 {
+		res[0] ^= 0xff
+	}
+	return res, err
+}
+
+func TestSecureField_Close(t *testing.T) {
+	sf := NewSecureField([]byte("ABCDABCDABCDABCD"), "abc")
+	val, err := sf.Value()
+	require.NoError(t, err)
+
+	err = sf.Close()
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 16), sf.secret)
+
+	_, err = sf.Value()
+	assert.Equal(t, errSecureFieldClosed, err)
+	err = sf.Scan(val)
+	assert.Equal(t, errSecureFieldClosed, err)
+	err = sf.Verify()
+	assert.Equal(t, errSecureFieldClosed, err)
+}
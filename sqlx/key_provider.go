@@ -0,0 +1,59 @@
+package sqlx
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+)
+
+// KeyProvider 密钥的来源，例如 KMS、Vault
+// 设置了 EncryptColumn.KeyProvider 之后，每次 Value 和 Scan 都会调用它获取密钥，
+// 对接信封加密的时候，可以在这里返回解密之后的数据密钥
+// 远程获取密钥的开销比较大的时候，可以使用 NewCachedKeyProvider 缓存结果
+type KeyProvider interface {
+	Key(ctx context.Context) ([]byte, error)
+}
+
+// KeyProviderFunc 将一个函数转化为 KeyProvider
+type KeyProviderFunc func(ctx context.Context) ([]byte, error)
+
+// Key 实现 KeyProvider 接口
+func (f KeyProviderFunc) Key(ctx context.Context) ([]byte, error) {
+	return f(ctx)
+}
+
+// NewCachedKeyProvider 缓存 p 返回的密钥，在 ttl 时间内不会再次调用 p
+// ttl <= 0 时永久缓存；p 返回错误的时候不会缓存
+// 返回的 KeyProvider 可以被多个 goroutine 同时使用
+// 每次返回的都是缓存的副本，调用者可以放心地清零或者修改
+func NewCachedKeyProvider(p KeyProvider, ttl time.Duration) KeyProvider {
+	return &cachedKeyProvider{
+		provider: p,
+		ttl:      ttl,
+	}
+}
+
+type cachedKeyProvider struct {
+	provider KeyProvider
+	ttl      time.Duration
+
+	mutex    sync.Mutex
+	key      []byte
+	expireAt time.Time
+}
+
+func (c *cachedKeyProvider) Key(ctx context.Context) ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.key != nil && (c.ttl <= 0 || time.Now().Before(c.expireAt)) {
+		return bytes.Clone(c.key), nil
+	}
+	key, err := c.provider.Key(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.key = bytes.Clone(key)
+	c.expireAt = time.Now().Add(c.ttl)
+	return key, nil
+}
@@ -0,0 +1,136 @@
+package sqlx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptColumn_KeyProvider(t *testing.T) {
+	key := "ABCDABCDABCDABCD"
+	provider := KeyProviderFunc(func(ctx context.Context) ([]byte, error) {
+		return []byte(key), nil
+	})
+	testCases := []struct {
+		name     string
+		provider KeyProvider
+		wantErr  string
+	}{
+		{
+			name:     "normal",
+			provider: provider,
+		},
+		{
+			name: "provider error",
+			provider: KeyProviderFunc(func(ctx context.Context) ([]byte, error) {
+				return nil, errors.New("kms unavailable")
+			}),
+			wantErr: "ekit EncryptColumn 获取key失败: kms unavailable",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := EncryptColumn[string]{KeyProvider: tc.provider, Val: "hello", Valid: true}.Value()
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// 和直接使用 Key 加密的数据互通
+			col := &EncryptColumn[string]{Key: key}
+			require.NoError(t, col.Scan(val))
+			assert.Equal(t, "hello", col.Val)
+
+			col = &EncryptColumn[string]{KeyProvider: tc.provider}
+			require.NoError(t, col.Scan(val))
+			assert.Equal(t, "hello", col.Val)
+		})
+	}
+}
+
+func TestEncryptColumn_KeyProviderInvalidKey(t *testing.T) {
+	provider := KeyProviderFunc(func(ctx context.Context) ([]byte, error) {
+		return []byte("ABCD"), nil
+	})
+	_, err := EncryptColumn[string]{KeyProvider: provider, Val: "hello", Valid: true}.Value()
+	assert.Error(t, err)
+}
+
+func TestNewCachedKeyProvider(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ttl       time.Duration
+		sleep     time.Duration
+		wantCalls int
+	}{
+		{
+			name:      "cache forever",
+			wantCalls: 1,
+		},
+		{
+			name:      "within ttl",
+			ttl:       time.Minute,
+			wantCalls: 1,
+		},
+		{
+			name:      "expired",
+			ttl:       time.Millisecond,
+			sleep:     time.Millisecond * 5,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			p := NewCachedKeyProvider(KeyProviderFunc(func(ctx context.Context) ([]byte, error) {
+				calls++
+				return []byte("ABCDABCDABCDABCD"), nil
+			}), tc.ttl)
+			_, err := p.Key(context.Background())
+			require.NoError(t, err)
+			time.Sleep(tc.sleep)
+			key, err := p.Key(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, []byte("ABCDABCDABCDABCD"), key)
+			assert.Equal(t, tc.wantCalls, calls)
+		})
+	}
+}
+
+func TestNewCachedKeyProvider_Copy(t *testing.T) {
+	p := NewCachedKeyProvider(KeyProviderFunc(func(ctx context.Context) ([]byte, error) {
+		return []byte("ABCDABCDABCDABCD"), nil
+	}), 0)
+	for i := 0; i < 2; i++ {
+		key, err := p.Key(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []byte("ABCDABCDABCDABCD"), key)
+		// 调用者清零密钥不会影响缓存
+		clear(key)
+	}
+}
+
+func TestNewCachedKeyProvider_Error(t *testing.T) {
+	calls := 0
+	p := NewCachedKeyProvider(KeyProviderFunc(func(ctx context.Context) ([]byte, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("kms unavailable")
+		}
+		return []byte("ABCDABCDABCDABCD"), nil
+	}), 0)
+	_, err := p.Key(context.Background())
+	assert.Error(t, err)
+	// 错误不会被缓存
+	key, err := p.Key(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ABCDABCDABCDABCD"), key)
+	assert.Equal(t, 2, calls)
+}
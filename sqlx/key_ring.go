@@ -0,0 +1,49 @@
+package sqlx
+
+import "fmt"
+
+// keyedHeaderMagic 带密钥版本的密文头部的第一个字节
+// 头部一共三个字节：keyedHeaderMagic、CipherSuite、密钥版本
+// 只有设置了 EncryptColumn.Keys 的时候才会写入该头部
+const keyedHeaderMagic byte = 0xED
+
+// newErrUnknownKeyVersion 创建一个代表 Keys 中没有对应版本密钥的错误
+func newErrUnknownKeyVersion(version byte) error {
+	return fmt.Errorf("ekit EncryptColumn 找不到版本为 %d 的key", version)
+}
+
+// parseKeyedHeader 解析带密钥版本的密文头部，返回算法、密钥版本以及去掉头部之后的数据
+// 没有该头部的时候 ok 为 false
+func parseKeyedHeader(data []byte) (suite CipherSuite, version byte, body []byte, ok bool) {
+	if len(data) < 3 || data[0] != keyedHeaderMagic {
+		return CipherSuiteAESGCM, 0, data, false
+	}
+	return CipherSuite(data[1]), data[2], data[3:], true
+}
+
+// AlgorithmOf 不解密，直接根据密文头部返回密文使用的加密算法，例如 "AES-GCM"、"ChaCha20-Poly1305"
+// 没有头部的密文返回 "AES-GCM"；密文本身不包含密钥，因此无法得知密钥的位数
+// 和 Scan 一样，头部记录了未知算法的密文被当作没有头部的旧密文处理，
+// 因为旧密文的 nonce 是随机的，可能恰好以头部的字节开头
+// blob 短于 nonce 和认证标签的长度之和时返回错误
+func AlgorithmOf(blob []byte) (string, error) {
+	if len(blob) < gcmStandardNonceSize+gcmTagSize {
+		return "", errCiphertextTooShort
+	}
+	suite, _, _, ok := parseKeyedHeader(blob)
+	if !ok {
+		suite, _, _ = parseCipherSuiteHeader(blob)
+	}
+	switch suite {
+	case CipherSuiteAESGCM, CipherSuiteChaCha20Poly1305:
+		return suite.String(), nil
+	default:
+		return CipherSuiteAESGCM.String(), nil
+	}
+}
+
+// gcmStandardNonceSize、gcmTagSize AES-GCM 和 ChaCha20-Poly1305 的 nonce 和认证标签长度
+const (
+	gcmStandardNonceSize = 12
+	gcmTagSize           = 16
+)
@@ -0,0 +1,151 @@
+package sqlx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptColumn_Keys(t *testing.T) {
+	keys := map[byte]string{
+		1: "ABCDABCDABCDABCD",
+		2: "BCDABCDABCDABCDABCDABCDABCDABCDA",
+	}
+	testCases := []struct {
+		name    string
+		version byte
+		suite   CipherSuite
+	}{
+		{
+			name:    "version 1",
+			version: 1,
+		},
+		{
+			name:    "version 2",
+			version: 2,
+		},
+		{
+			name:    "version 2 chacha20 poly1305",
+			version: 2,
+			suite:   CipherSuiteChaCha20Poly1305,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := EncryptColumn[string]{Keys: keys, KeyVersion: tc.version, Suite: tc.suite,
+				Val: "hello", Valid: true}.Value()
+			require.NoError(t, err)
+			encrypted := val.([]byte)
+			assert.Equal(t, []byte{keyedHeaderMagic, byte(tc.suite), tc.version}, encrypted[:3])
+
+			// 解密时根据头部选择密钥，和当前的 KeyVersion 无关
+			col := &EncryptColumn[string]{Keys: keys, KeyVersion: 2}
+			require.NoError(t, col.Scan(encrypted))
+			assert.Equal(t, "hello", col.Val)
+			assert.True(t, col.Valid)
+		})
+	}
+}
+
+func TestEncryptColumn_KeysLegacy(t *testing.T) {
+	oldKey := "ABCDABCDABCDABCD"
+	keys := map[byte]string{1: "BCDABCDABCDABCDA"}
+	// 轮换之前没有头部的密文
+	val, err := EncryptColumn[string]{Key: oldKey, Val: "hello", Valid: true}.Value()
+	require.NoError(t, err)
+
+	col := &EncryptColumn[string]{Key: oldKey, Keys: keys, KeyVersion: 1}
+	require.NoError(t, col.Scan(val))
+	assert.Equal(t, "hello", col.Val)
+}
+
+func TestEncryptColumn_KeysErr(t *testing.T) {
+	keys := map[byte]string{1: "ABCDABCDABCDABCD"}
+	// 加密使用的版本不存在
+	_, err := EncryptColumn[string]{Keys: keys, KeyVersion: 2, Val: "hello", Valid: true}.Value()
+	assert.Equal(t, newErrUnknownKeyVersion(2), err)
+	assert.Equal(t, 0, EncryptColumn[string]{Keys: keys, KeyVersion: 2}.KeyBits())
+
+	// 解密时找不到对应版本的密钥
+	val, err := EncryptColumn[string]{Keys: keys, KeyVersion: 1, Val: "hello", Valid: true}.Value()
+	require.NoError(t, err)
+	col := &EncryptColumn[string]{Keys: map[byte]string{3: "ABCDABCDABCDABCD"}}
+	assert.Equal(t, newErrUnknownKeyVersion(1), col.Scan(val))
+}
+
+func TestEncryptColumn_KeysAlgorithm(t *testing.T) {
+	col := EncryptColumn[string]{
+		Key:        "ABCDABCDABCDABCD",
+		Keys:       map[byte]string{1: "ABCDABCDABCDABCDABCDABCDABCDABCD"},
+		KeyVersion: 1,
+	}
+	// 使用当前版本的密钥
+	assert.Equal(t, "AES-256-GCM", col.Algorithm())
+}
+
+func TestAlgorithmOf(t *testing.T) {
+	aesKey, chachaKey := "ABCDABCDABCDABCD", "ABCDABCDABCDABCDABCDABCDABCDABCD"
+	encrypt := func(col EncryptColumn[string]) []byte {
+		col.Val, col.Valid = "hello", true
+		val, err := col.Value()
+		require.NoError(t, err)
+		return val.([]byte)
+	}
+	testCases := []struct {
+		name    string
+		blob    []byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "aes gcm without header",
+			blob: encrypt(EncryptColumn[string]{Key: aesKey}),
+			want: "AES-GCM",
+		},
+		{
+			name: "chacha20 poly1305",
+			blob: encrypt(EncryptColumn[string]{Key: chachaKey, Suite: CipherSuiteChaCha20Poly1305}),
+			want: "ChaCha20-Poly1305",
+		},
+		{
+			name: "key version aes gcm",
+			blob: encrypt(EncryptColumn[string]{Keys: map[byte]string{1: aesKey}, KeyVersion: 1}),
+			want: "AES-GCM",
+		},
+		{
+			name: "key version chacha20 poly1305",
+			blob: encrypt(EncryptColumn[string]{Keys: map[byte]string{1: chachaKey}, KeyVersion: 1,
+				Suite: CipherSuiteChaCha20Poly1305}),
+			want: "ChaCha20-Poly1305",
+		},
+		{
+			// 按照旧密文处理
+			name: "unknown suite",
+			blob: append([]byte{cipherSuiteMagic, 99}, make([]byte, 28)...),
+			want: "AES-GCM",
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+		{
+			name:    "too short",
+			blob:    []byte{keyedHeaderMagic, byte(CipherSuiteChaCha20Poly1305), 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := AlgorithmOf(tc.blob)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}